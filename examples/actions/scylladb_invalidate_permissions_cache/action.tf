@@ -0,0 +1,23 @@
+# Wait for changed grants of the analyst role to take effect before dependent steps run
+action "scylladb_invalidate_permissions_cache" "analyst" {
+  config {
+    role = scylladb_role_grants.analyst.role
+  }
+}
+
+resource "scylladb_role_grants" "analyst" {
+  role = "analyst"
+
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["SELECT"]
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.scylladb_invalidate_permissions_cache.analyst]
+    }
+  }
+}
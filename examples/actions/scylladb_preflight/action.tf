@@ -0,0 +1,13 @@
+# Check the cluster before the roles are changed
+action "scylladb_preflight" "check" {}
+
+resource "scylladb_role" "analyst" {
+  role = "analyst"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.scylladb_preflight.check]
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# Verify that the app role can log in after it is created
+action "scylladb_verify_login" "app" {
+  config {
+    username = scylladb_role.app.role
+    password = var.app_password
+  }
+}
+
+resource "scylladb_role" "app" {
+  role      = "app"
+  can_login = true
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.scylladb_verify_login.app]
+    }
+  }
+}
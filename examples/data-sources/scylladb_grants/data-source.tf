@@ -0,0 +1,14 @@
+# List the permissions of a role, including those inherited from the roles it is a member of
+data "scylladb_grants" "app" {
+  role_name = "app"
+}
+
+# List only the permissions granted to the role directly
+data "scylladb_grants" "app_direct" {
+  role_name = "app"
+  recursive = false
+}
+
+output "app_permissions" {
+  value = { for g in data.scylladb_grants.app.grants : "${g.resource} ${g.permission}" => g.role }
+}
@@ -0,0 +1,7 @@
+data "scylladb_hcl_export" "all" {}
+
+# Review the generated configuration with `terraform output -raw scylladb_import`
+# before copying the parts to manage into the configuration.
+output "scylladb_import" {
+  value = data.scylladb_hcl_export.all.hcl
+}
@@ -0,0 +1,14 @@
+data "scylladb_keyspace" "cycling" {
+  name = "cycling"
+}
+
+# Fail the plan when a datacenter holds fewer replicas than configured
+check "cycling_fully_replicated" {
+  assert {
+    condition = alltrue([
+      for dc, factor in data.scylladb_keyspace.cycling.datacenters :
+      data.scylladb_keyspace.cycling.effective_replication[dc] == factor
+    ])
+    error_message = "A datacenter has fewer nodes than the replication factor of the cycling keyspace."
+  }
+}
@@ -0,0 +1,11 @@
+# List all keyspaces except the ones ScyllaDB uses internally
+data "scylladb_keyspaces" "all" {}
+
+# Let the analyst role read every keyspace
+resource "scylladb_grant" "analyst_select" {
+  for_each      = toset([for ks in data.scylladb_keyspaces.all.keyspaces : ks.name])
+  role_name     = "analyst"
+  privilege     = "SELECT"
+  resource_type = "KEYSPACE"
+  keyspace      = each.key
+}
@@ -0,0 +1,10 @@
+# The privileges ALL PERMISSIONS grants on a table
+data "scylladb_privilege_expansion" "table_all" {
+  privilege     = "ALL PERMISSIONS"
+  resource_type = "TABLE"
+}
+
+output "table_all_privileges" {
+  # ["ALTER", "AUTHORIZE", "DROP", "MODIFY", "SELECT"]
+  value = data.scylladb_privilege_expansion.table_all.privileges
+}
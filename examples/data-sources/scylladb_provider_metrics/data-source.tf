@@ -0,0 +1,12 @@
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+
+# Read the metrics after the data sources it depends on
+data "scylladb_provider_metrics" "run" {
+  depends_on = [data.scylladb_role.cassandra]
+}
+
+output "query_errors" {
+  value = data.scylladb_provider_metrics.run.errors
+}
@@ -2,3 +2,16 @@
 data "scylladb_role" "cassandra" {
   id = "cassandra"
 }
+
+# Check what the analyst role can do on a table, including privileges
+# inherited from its parent roles and granted on the keyspace
+data "scylladb_role" "analyst_on_cyclist_name" {
+  id            = "analyst"
+  resource_type = "TABLE"
+  keyspace      = "cycling"
+  identifier    = "cyclist_name"
+}
+
+output "analyst_can_read_cyclist_name" {
+  value = contains(data.scylladb_role.analyst_on_cyclist_name.effective_permissions, "SELECT")
+}
@@ -0,0 +1,25 @@
+# List all roles
+data "scylladb_roles" "all" {}
+
+# List only the roles that can log in
+data "scylladb_roles" "logins" {
+  can_login_only = true
+}
+
+# Import every existing role except the default superuser (Terraform 1.7+)
+locals {
+  roles = { for r in data.scylladb_roles.all.roles : r.role => r if r.role != "cassandra" }
+}
+
+import {
+  for_each = local.roles
+  to       = scylladb_role.imported[each.key]
+  id       = each.key
+}
+
+resource "scylladb_role" "imported" {
+  for_each     = local.roles
+  role         = each.key
+  can_login    = each.value.can_login
+  is_superuser = each.value.is_superuser
+}
@@ -0,0 +1,7 @@
+# Issue a client certificate for the cassandra role that is valid for 30 minutes
+ephemeral "scylladb_client_certificate" "cassandra" {
+  ca_cert     = file("ca.crt")
+  ca_key      = file("ca.key")
+  common_name = "cassandra"
+  validity    = "30m"
+}
@@ -0,0 +1,2 @@
+# Import a keyspace resource by specifying the keyspace name.
+terraform import scylladb_keyspace.example keyspace_name
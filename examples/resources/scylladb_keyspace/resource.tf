@@ -0,0 +1,14 @@
+resource "scylladb_keyspace" "cycling" {
+  name               = "cycling"
+  replication_factor = 3
+}
+
+resource "scylladb_keyspace" "analytics" {
+  name              = "analytics"
+  replication_class = "NetworkTopologyStrategy"
+  datacenters = {
+    "us-east" = 3
+    "us-west" = 2
+  }
+  durable_writes = true
+}
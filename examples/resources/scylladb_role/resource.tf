@@ -4,3 +4,18 @@ resource "scylladb_role" "admin" {
   can_login    = false
   is_superuser = true
 }
+
+# Make analyst a member of readers only, revoking any role granted to it outside of Terraform
+resource "scylladb_role" "analyst" {
+  role                      = "analyst"
+  can_login                 = true
+  member_of                 = ["readers"]
+  authoritative_memberships = true
+}
+
+# Create a login role whose password is read from the ETL_PASSWORD environment variable
+resource "scylladb_role" "etl" {
+  role         = "etl"
+  can_login    = true
+  password_env = "ETL_PASSWORD"
+}
@@ -0,0 +1,2 @@
+# Import a role grants resource by specifying the role name.
+terraform import scylladb_role_grants.example role_name
@@ -0,0 +1,21 @@
+resource "scylladb_role" "analyst" {
+  role      = "analyst"
+  can_login = false
+}
+
+resource "scylladb_role_grants" "analyst" {
+  role = scylladb_role.analyst.role
+
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["SELECT"]
+  }
+
+  grant {
+    resource_type = "TABLE"
+    keyspace      = "cycling"
+    identifier    = "cyclist_name"
+    privileges    = ["MODIFY", "SELECT"]
+  }
+}
@@ -0,0 +1,2 @@
+# Import a schema resource by specifying the keyspace name.
+terraform import scylladb_schema.example keyspace_name
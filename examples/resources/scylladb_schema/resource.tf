@@ -0,0 +1,44 @@
+resource "scylladb_schema" "cycling" {
+  keyspace           = "cycling"
+  replication_factor = 3
+
+  table {
+    name          = "cyclist_name"
+    columns       = { id = "uuid", firstname = "text", lastname = "text" }
+    partition_key = ["id"]
+  }
+
+  table {
+    name           = "race_times"
+    columns        = { race_id = "uuid", rank = "int", cyclist_id = "uuid", race_time = "duration" }
+    partition_key  = ["race_id"]
+    clustering_key = ["rank"]
+  }
+
+  table {
+    name             = "rank_by_year"
+    columns          = { race_year = "int", rank = "int", cyclist_name = "text" }
+    partition_key    = ["race_year"]
+    clustering_key   = ["rank", "cyclist_name"]
+    clustering_order = { rank = "DESC", cyclist_name = "ASC" }
+    properties = {
+      compaction       = "LeveledCompactionStrategy"
+      gc_grace_seconds = "86400"
+    }
+  }
+}
+
+resource "scylladb_schema" "analytics" {
+  keyspace          = "analytics"
+  replication_class = "NetworkTopologyStrategy"
+  datacenters = {
+    "us-east" = 3
+    "us-west" = 2
+  }
+
+  table {
+    name          = "events"
+    columns       = { id = "timeuuid", payload = "text" }
+    partition_key = ["id"]
+  }
+}
@@ -0,0 +1,48 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.ConfigValidator = authMethodValidator{}
+
+// authMethodValidator ensures at most one authentication method is configured. TLS used only for
+// transport encryption (a CA certificate) may be combined with password authentication, but a
+// client certificate in auth_tls is an authentication method of its own.
+type authMethodValidator struct{}
+
+func (v authMethodValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v authMethodValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures `auth_login_userpass` and client certificate authentication in `auth_tls` are not both configured."
+}
+
+func (v authMethodValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data scylladbProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasPasswordAuth := data.AuthLoginUserPass != nil
+	hasClientCertAuth := data.AuthTLS != nil && (!data.AuthTLS.CertFile.IsNull() || !data.AuthTLS.KeyFile.IsNull() ||
+		!data.AuthTLS.CertBase64.IsNull() || !data.AuthTLS.KeyBase64.IsNull())
+	if hasPasswordAuth && hasClientCertAuth {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_tls"),
+			"Conflicting Authentication Methods",
+			"Both password authentication (`auth_login_userpass`) and client certificate authentication (`auth_tls`) are configured, "+
+				"but only one authentication method can be active.\n\n"+
+				"TLS for encryption only needs a CA certificate (`ca_cert` or `ca_cert_file`) and can be combined with `auth_login_userpass`. "+
+				"TLS for authentication presents a client certificate from `auth_tls` and replaces the username and password. "+
+				"Remove either `auth_login_userpass` or `auth_tls`.",
+		)
+	}
+}
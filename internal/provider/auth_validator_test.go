@@ -0,0 +1,135 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAuthMethodValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		wantError bool
+	}{
+		{
+			name:      "no authentication",
+			wantError: false,
+		},
+		{
+			name: "password authentication",
+			values: map[string]tftypes.Value{
+				"auth_login_userpass": testUserPassBlock(),
+			},
+			wantError: false,
+		},
+		{
+			name: "client certificate authentication",
+			values: map[string]tftypes.Value{
+				"ca_cert_file": tftypes.NewValue(tftypes.String, "/tmp/ca.crt"),
+				"auth_tls":     testAuthTLSBlock("/tmp/client.crt", "/tmp/client.key"),
+			},
+			wantError: false,
+		},
+		{
+			name: "TLS encryption with password authentication",
+			values: map[string]tftypes.Value{
+				"ca_cert_file":        tftypes.NewValue(tftypes.String, "/tmp/ca.crt"),
+				"auth_login_userpass": testUserPassBlock(),
+			},
+			wantError: false,
+		},
+		{
+			name: "empty auth_tls with password authentication",
+			values: map[string]tftypes.Value{
+				"auth_tls":            testAuthTLSBlock("", ""),
+				"auth_login_userpass": testUserPassBlock(),
+			},
+			wantError: false,
+		},
+		{
+			name: "client certificate and password authentication",
+			values: map[string]tftypes.Value{
+				"ca_cert_file":        tftypes.NewValue(tftypes.String, "/tmp/ca.crt"),
+				"auth_tls":            testAuthTLSBlock("/tmp/client.crt", "/tmp/client.key"),
+				"auth_login_userpass": testUserPassBlock(),
+			},
+			wantError: true,
+		},
+		{
+			name: "base64 client certificate and password authentication",
+			values: map[string]tftypes.Value{
+				"auth_tls":            testAuthTLSAttributes(map[string]string{"cert_base64": "Y2VydA==", "key_base64": "a2V5"}),
+				"auth_login_userpass": testUserPassBlock(),
+			},
+			wantError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := provider.ValidateConfigRequest{Config: testProviderConfig(t, tc.values)}
+			resp := &provider.ValidateConfigResponse{}
+			authMethodValidator{}.ValidateProvider(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error = %v, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testProviderConfig builds a provider configuration where every attribute and block not in
+// values is null.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &provider.SchemaResponse{}
+	New("test")().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func testUserPassBlock() tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"username": tftypes.String,
+		"password": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"username": tftypes.NewValue(tftypes.String, "cassandra"),
+		"password": tftypes.NewValue(tftypes.String, "cassandra"),
+	})
+}
+
+// testAuthTLSBlock returns an auth_tls block; empty paths are left unset.
+func testAuthTLSBlock(certFile, keyFile string) tftypes.Value {
+	return testAuthTLSAttributes(map[string]string{"cert_file": certFile, "key_file": keyFile})
+}
+
+// testAuthTLSAttributes returns an auth_tls block with the given attributes set; the others are
+// left unset.
+func testAuthTLSAttributes(values map[string]string) tftypes.Value {
+	attributeTypes := make(map[string]tftypes.Type)
+	attributes := make(map[string]tftypes.Value)
+	for _, name := range []string{"cert_file", "key_file", "cert_base64", "key_base64"} {
+		attributeTypes[name] = tftypes.String
+		attributes[name] = tftypes.NewValue(tftypes.String, nil)
+		if value := values[name]; value != "" {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		}
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributes)
+}
@@ -0,0 +1,88 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = caseInsensitiveStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = caseInsensitiveString{}
+)
+
+// caseInsensitiveStringType is the type of CQL keywords such as privileges, which the database
+// reports in uppercase whatever case they were given in. Values that only differ in case are
+// semantically equal, so that neither the configuration nor the state has to match its case.
+type caseInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+func (t caseInsensitiveStringType) Equal(o attr.Type) bool {
+	other, ok := o.(caseInsensitiveStringType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t caseInsensitiveStringType) String() string {
+	return "caseInsensitiveStringType"
+}
+
+func (t caseInsensitiveStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return caseInsensitiveString{StringValue: in}, nil
+}
+
+func (t caseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return caseInsensitiveString{StringValue: stringValue}, nil
+}
+
+func (t caseInsensitiveStringType) ValueType(context.Context) attr.Value {
+	return caseInsensitiveString{}
+}
+
+// caseInsensitiveString is a value of caseInsensitiveStringType.
+type caseInsensitiveString struct {
+	basetypes.StringValue
+}
+
+// upperCaseString returns value in uppercase, as the database reports it.
+func upperCaseString(value string) caseInsensitiveString {
+	return caseInsensitiveString{StringValue: basetypes.NewStringValue(strings.ToUpper(value))}
+}
+
+func (v caseInsensitiveString) Equal(o attr.Value) bool {
+	other, ok := o.(caseInsensitiveString)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v caseInsensitiveString) Type(context.Context) attr.Type {
+	return caseInsensitiveStringType{}
+}
+
+// StringSemanticEquals reports whether v and newValuable only differ in case.
+func (v caseInsensitiveString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(caseInsensitiveString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
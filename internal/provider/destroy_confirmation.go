@@ -0,0 +1,32 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// confirmDestroyEnvVar must be set to "true" for deletes to proceed when the provider is
+// configured with require_destroy_confirmation.
+const confirmDestroyEnvVar = "SCYLLADB_CONFIRM_DESTROY"
+
+// checkDestroyConfirmation returns an error diagnostic when destroy confirmation is required but
+// has not been given. Resources call it at the start of Delete.
+func checkDestroyConfirmation(client *scylladb.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || !client.RequireDestroyConfirmation {
+		return diags
+	}
+	if os.Getenv(confirmDestroyEnvVar) != "true" {
+		diags.AddError(
+			"Destroy Not Confirmed",
+			"The provider is configured with `require_destroy_confirmation`, so resources are only deleted when the "+
+				confirmDestroyEnvVar+" environment variable is set to `true`. "+
+				"Set it for the run that is expected to delete resources and try again.",
+		)
+	}
+	return diags
+}
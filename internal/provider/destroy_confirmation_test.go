@@ -0,0 +1,33 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestCheckDestroyConfirmation(t *testing.T) {
+	tests := []struct {
+		name      string
+		require   bool
+		envValue  string
+		wantError bool
+	}{
+		{name: "not required", require: false, envValue: "", wantError: false},
+		{name: "required and confirmed", require: true, envValue: "true", wantError: false},
+		{name: "required and unconfirmed", require: true, envValue: "", wantError: true},
+		{name: "required and not true", require: true, envValue: "yes", wantError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(confirmDestroyEnvVar, tc.envValue)
+			client := &scylladb.Cluster{RequireDestroyConfirmation: tc.require}
+			diags := checkDestroyConfirmation(client)
+			if diags.HasError() != tc.wantError {
+				t.Errorf("expected error = %v, got diagnostics: %v", tc.wantError, diags)
+			}
+		})
+	}
+}
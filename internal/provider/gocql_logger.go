@@ -0,0 +1,71 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// driverLogLevels maps the driver_log_level values to gocql log levels.
+var driverLogLevels = map[string]gocql.LogLevel{
+	"none":  gocql.LogLevelNone,
+	"error": gocql.LogLevelError,
+	"warn":  gocql.LogLevelWarn,
+	"info":  gocql.LogLevelInfo,
+	"debug": gocql.LogLevelDebug,
+}
+
+const defaultDriverLogLevel = "warn"
+
+// tflogLogger routes gocql's internal logging into tflog so driver diagnostics show up in
+// Terraform's log output instead of on stderr. Messages above level are dropped; the rest are
+// logged at the matching tflog level and are still subject to TF_LOG filtering.
+type tflogLogger struct {
+	ctx   context.Context
+	level gocql.LogLevel
+}
+
+var _ gocql.StructuredLogger = &tflogLogger{}
+
+func newTflogLogger(ctx context.Context, level string) *tflogLogger {
+	return &tflogLogger{
+		ctx:   tflog.SetField(ctx, "component", "gocql"),
+		level: driverLogLevels[strings.ToLower(level)],
+	}
+}
+
+func (l *tflogLogger) Error(msg string, fields ...gocql.LogField) {
+	if gocql.LogLevelError <= l.level {
+		tflog.Error(l.ctx, msg, logFieldsToMap(fields))
+	}
+}
+
+func (l *tflogLogger) Warning(msg string, fields ...gocql.LogField) {
+	if gocql.LogLevelWarn <= l.level {
+		tflog.Warn(l.ctx, msg, logFieldsToMap(fields))
+	}
+}
+
+func (l *tflogLogger) Info(msg string, fields ...gocql.LogField) {
+	if gocql.LogLevelInfo <= l.level {
+		tflog.Info(l.ctx, msg, logFieldsToMap(fields))
+	}
+}
+
+func (l *tflogLogger) Debug(msg string, fields ...gocql.LogField) {
+	if gocql.LogLevelDebug <= l.level {
+		tflog.Debug(l.ctx, msg, logFieldsToMap(fields))
+	}
+}
+
+func logFieldsToMap(fields []gocql.LogField) map[string]any {
+	values := make(map[string]any, len(fields))
+	for _, field := range fields {
+		values[field.Name] = field.Value.Any()
+	}
+	return values
+}
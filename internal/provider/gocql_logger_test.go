@@ -0,0 +1,53 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestTflogLogger(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	logger := newTflogLogger(ctx, "info")
+	logger.Warning("Failed to connect", gocql.NewLogFieldString("host", "10.0.0.1"), gocql.NewLogFieldInt("port", 9042))
+	logger.Info("Session initialized")
+	logger.Debug("Dropped by the level filter")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %v", len(entries), entries)
+	}
+
+	warning := entries[0]
+	if warning["@level"] != "warn" || warning["@message"] != "Failed to connect" {
+		t.Errorf("unexpected warning entry: %v", warning)
+	}
+	if warning["host"] != "10.0.0.1" || warning["port"] != float64(9042) || warning["component"] != "gocql" {
+		t.Errorf("expected gocql fields in warning entry: %v", warning)
+	}
+	if entries[1]["@level"] != "info" {
+		t.Errorf("unexpected info entry: %v", entries[1])
+	}
+}
+
+func TestTflogLoggerNone(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	logger := newTflogLogger(ctx, "none")
+	logger.Error("Dropped by the level filter")
+
+	if output.Len() != 0 {
+		t.Errorf("expected no log output, got %s", output.String())
+	}
+}
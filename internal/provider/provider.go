@@ -3,17 +3,30 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"os"
-
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/retailnext/terraform-provider-scylladb/scylladb"
@@ -24,6 +37,7 @@ var _ provider.Provider = &scylladbProvider{}
 var _ provider.ProviderWithFunctions = &scylladbProvider{}
 var _ provider.ProviderWithEphemeralResources = &scylladbProvider{}
 var _ provider.ProviderWithActions = &scylladbProvider{}
+var _ provider.ProviderWithConfigValidators = &scylladbProvider{}
 
 // ScylladbProvider defines the provider implementation.
 type scylladbProvider struct {
@@ -35,13 +49,42 @@ type scylladbProvider struct {
 
 // scylladbProviderModel describes the provider data model.
 type scylladbProviderModel struct {
-	Host                 types.String            `tfsdk:"host"`
-	SystemAuthKeyspace   types.String            `tfsdk:"system_auth_keyspace"`
-	SkipHostVerification types.Bool              `tfsdk:"skip_host_verification"`
-	CAcert               types.String            `tfsdk:"ca_cert"`
-	CAcertFile           types.String            `tfsdk:"ca_cert_file"`
-	AuthLoginUserPass    *authLoginUserPassModel `tfsdk:"auth_login_userpass"`
-	AuthTLS              *authTLSModel           `tfsdk:"auth_tls"`
+	Host                       types.String            `tfsdk:"host"`
+	Hosts                      types.List              `tfsdk:"hosts"`
+	Port                       types.Int64             `tfsdk:"port"`
+	SystemAuthKeyspace         types.String            `tfsdk:"system_auth_keyspace"`
+	SkipHostVerification       types.Bool              `tfsdk:"skip_host_verification"`
+	CAcert                     types.String            `tfsdk:"ca_cert"`
+	CAcertFile                 types.String            `tfsdk:"ca_cert_file"`
+	CAcertBase64               types.String            `tfsdk:"ca_cert_base64"`
+	CAcertDir                  types.String            `tfsdk:"ca_cert_dir"`
+	AuthLoginUserPass          *authLoginUserPassModel `tfsdk:"auth_login_userpass"`
+	AuthTLS                    *authTLSModel           `tfsdk:"auth_tls"`
+	HostFilter                 *hostFilterModel        `tfsdk:"host_filter"`
+	RequireDestroyConfirmation types.Bool              `tfsdk:"require_destroy_confirmation"`
+	RequestTimeout             types.String            `tfsdk:"request_timeout"`
+	ConnectTimeout             types.String            `tfsdk:"connect_timeout"`
+	DDLTimeout                 types.String            `tfsdk:"ddl_timeout"`
+	DriverLogLevel             types.String            `tfsdk:"driver_log_level"`
+	DialTimeout                types.String            `tfsdk:"dial_timeout"`
+	LocalAddr                  types.String            `tfsdk:"local_addr"`
+	DualStack                  types.Bool              `tfsdk:"dual_stack"`
+	MaxIdleTime                types.String            `tfsdk:"max_idle_time"`
+	GrantVerifyAttempts        types.Int64             `tfsdk:"grant_verify_attempts"`
+	ReadConsistencyFallback    types.String            `tfsdk:"read_consistency_fallback"`
+	Consistency                types.String            `tfsdk:"consistency"`
+	LocalDatacenter            types.String            `tfsdk:"local_datacenter"`
+	RetryAttempts              types.Int64             `tfsdk:"retry_attempts"`
+	FailoverContactPoints      types.Bool              `tfsdk:"failover_contact_points"`
+	RetryBackoff               types.Bool              `tfsdk:"retry_backoff"`
+	TraceQueries               types.Bool              `tfsdk:"trace_queries"`
+	PinWritesToCoordinator     types.Bool              `tfsdk:"pin_writes_to_coordinator"`
+	TLSDisableSessionTickets   types.Bool              `tfsdk:"tls_disable_session_tickets"`
+	TLSRenegotiation           types.String            `tfsdk:"tls_renegotiation"`
+	IdempotentDDL              types.Bool              `tfsdk:"idempotent_ddl"`
+	ConnectConcurrency         types.Int64             `tfsdk:"connect_concurrency"`
+	DisableInitialHostLookup   types.Bool              `tfsdk:"disable_initial_host_lookup"`
+	NumConns                   types.Int64             `tfsdk:"num_conns"`
 }
 
 type authLoginUserPassModel struct {
@@ -50,8 +93,15 @@ type authLoginUserPassModel struct {
 }
 
 type authTLSModel struct {
-	CertFile types.String `tfsdk:"cert_file"`
-	KeyFile  types.String `tfsdk:"key_file"`
+	CertFile   types.String `tfsdk:"cert_file"`
+	KeyFile    types.String `tfsdk:"key_file"`
+	CertBase64 types.String `tfsdk:"cert_base64"`
+	KeyBase64  types.String `tfsdk:"key_base64"`
+}
+
+type hostFilterModel struct {
+	Hosts      types.List   `tfsdk:"hosts"`
+	Datacenter types.String `tfsdk:"datacenter"`
 }
 
 func (p *scylladbProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -64,25 +114,203 @@ func (p *scylladbProvider) Schema(ctx context.Context, req provider.SchemaReques
 		Description: "Configure access to ScyllaDB.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				MarkdownDescription: "Hostname or IP address of the ScyllaDB instance with a port if necessary. e.g. localhost:9042",
-				Optional:            true,
+				MarkdownDescription: "Hostname or IP address of the ScyllaDB instance with a port if necessary. e.g. localhost:9042. " +
+					"Without a port, `port` is used. " +
+					"Use `unix:///path/to/socket` to connect over a Unix domain socket, which cannot be combined with a proxy, TLS, or dial settings.",
+				Optional: true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "Hostnames or IP addresses of several ScyllaDB instances to use as contact points, with a port if necessary. " +
+					"Those without a port use `port`. The provider connects as long as one of them is up. Mutually exclusive with `host`. " +
+					"Can also be set via the `SCYLLADB_HOSTS` environment variable, separated by commas.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("host")),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
 			},
 			"system_auth_keyspace": schema.StringAttribute{
 				MarkdownDescription: "The keyspace where ScyllaDB stores authentication and authorization information. Default is `system`.",
 				Optional:            true,
 			},
 			"ca_cert": schema.StringAttribute{
-				MarkdownDescription: "PEM-encoded CA certificate content for TLS connections. Mutually exclusive with `ca_cert_file`. Can also be set via the `SCYLLADB_CA_CERT` environment variable.",
+				MarkdownDescription: "PEM-encoded CA certificate content for TLS connections. Mutually exclusive with `ca_cert_file` and `ca_cert_base64`. Can also be set via the `SCYLLADB_CA_CERT` environment variable.",
 				Optional:            true,
 			},
 			"ca_cert_file": schema.StringAttribute{
-				MarkdownDescription: "Path to the CA certificate file for TLS connections. Mutually exclusive with `ca_cert`.",
+				MarkdownDescription: "Path to the CA certificate file for TLS connections. Mutually exclusive with `ca_cert` and `ca_cert_base64`.",
 				Optional:            true,
 			},
+			"ca_cert_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded PEM CA certificate for TLS connections, as returned by some secret stores. Mutually exclusive with `ca_cert` and `ca_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_dir": schema.StringAttribute{
+				MarkdownDescription: "Path to a directory of CA certificates for TLS connections. Every `.pem` and `.crt` file in it is trusted, " +
+					"in addition to the CA certificate set with `ca_cert`, `ca_cert_file`, or `ca_cert_base64`. " +
+					"Files that cannot be read or contain no certificate are skipped with a warning.",
+				Optional: true,
+			},
 			"skip_host_verification": schema.BoolAttribute{
 				MarkdownDescription: "Skip TLS host verification. Default is `false`.",
 				Optional:            true,
 			},
+			"tls_disable_session_tickets": schema.BoolAttribute{
+				MarkdownDescription: "Do not resume TLS sessions with session tickets, so every connection performs a full handshake. " +
+					"This is an advanced setting for network appliances with strict TLS policies. Default is `false`.",
+				Optional: true,
+			},
+			"tls_renegotiation": schema.StringAttribute{
+				MarkdownDescription: "Whether the server may renegotiate TLS connections. One of `never`, `once`, or `freely`. " +
+					"This is an advanced setting for network appliances with strict TLS policies. Default is `never`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("never", "once", "freely"),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for regular queries, such as reading roles and managing grants, as a Go duration string. Default is `11s`. " +
+					"Can also be set via the `SCYLLADB_REQUEST_TIMEOUT` environment variable.",
+				Optional: true,
+			},
+			"connect_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for connecting to a node, from the TCP connection through the CQL handshake, as a Go duration string. " +
+					"Lower it to fail fast when a node is unreachable. Defaults to the driver's connect timeout. " +
+					"Can also be set via the `SCYLLADB_CONNECT_TIMEOUT` environment variable.",
+				Optional: true,
+			},
+			"ddl_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for schema-altering statements (keyspaces, tables, types, and indexes) as a Go duration string. Default is `1m`.",
+				Optional:            true,
+			},
+			"dial_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for establishing a TCP connection to a node, as a Go duration string. Defaults to the driver's connect timeout. Not supported with a proxy.",
+				Optional:            true,
+			},
+			"local_addr": schema.StringAttribute{
+				MarkdownDescription: "Local IP address to connect from, for hosts with multiple network interfaces. Not supported with a proxy.",
+				Optional:            true,
+			},
+			"dual_stack": schema.BoolAttribute{
+				MarkdownDescription: "When a host resolves to both IPv4 and IPv6 addresses, fall back to the other address family if the first one is slow to connect. Set to `false` to only try the first address family. Default is `true`. Not supported with a proxy.",
+				Optional:            true,
+			},
+			"max_idle_time": schema.StringAttribute{
+				MarkdownDescription: "Ping the cluster before the next query when the session was idle for longer than this, as a Go duration string. " +
+					"This drops connections that a proxy or load balancer reset while idle, instead of failing the query. Disabled by default.",
+				Optional: true,
+			},
+			"grant_verify_attempts": schema.Int64Attribute{
+				MarkdownDescription: "How many times to read the permissions of a grant after creating it until they show up. " +
+					"Nodes with a nonzero `permissions_validity_in_ms` may serve stale permissions for a while after a GRANT; the reads back off from 200ms, doubling each time. Default is `5`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"connect_concurrency": schema.Int64Attribute{
+				MarkdownDescription: "How many connections to the cluster are established at the same time, and how many contact point host names are resolved at the same time. " +
+					"By default the driver connects to all hosts at once, which can overwhelm a proxy when there are many contact points. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"disable_initial_host_lookup": schema.BoolAttribute{
+				MarkdownDescription: "Only connect to the contact points, instead of also discovering the other nodes of the cluster and connecting to them. " +
+					"Default is `true`, which suits a proxy tunnel; set to `false` to spread load over a large cluster. Not supported with a Unix socket host.",
+				Optional: true,
+			},
+			"num_conns": schema.Int64Attribute{
+				MarkdownDescription: "The number of connections opened to each host. Default is `1`, which suits a single-connection proxy tunnel. " +
+					"A higher value such as `2` to `4` is recommended when connecting to the cluster directly.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port to connect to on the hosts that do not include one. IPv6 addresses are bracketed when the port is added. Default is `9042`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"failover_contact_points": schema.BoolAttribute{
+				MarkdownDescription: "Fail over to another contact point when the ones in use go down, e.g. during node maintenance. " +
+					"The contact points that are down are retried every second instead of every minute, and an operation started while none of them is up " +
+					"waits up to 10s for one to come back instead of failing right away. Most useful with several `hosts` and `disable_initial_host_lookup`. Default is `false`.",
+				Optional: true,
+			},
+			"retry_attempts": schema.Int64Attribute{
+				MarkdownDescription: "How many times a failed grant, revoke, or role creation is retried on another node, e.g. during a rolling restart. " +
+					"Only statements that have the same effect when run again are retried: roles are only created with a retry when `idempotent_ddl` is `true`, " +
+					"and drops are never retried, so their errors still surface. Default is `0`, no retries.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff": schema.BoolAttribute{
+				MarkdownDescription: "Wait before each retry set with `retry_attempts`, from 100ms doubling up to 5s, instead of retrying right away. Default is `false`.",
+				Optional:            true,
+			},
+			"local_datacenter": schema.StringAttribute{
+				MarkdownDescription: "Datacenter whose nodes the provider prefers, sending each query to a replica of its data there. " +
+					"Nodes of other datacenters are only used when none of the local ones is up, which reduces cross-datacenter traffic in geo-distributed clusters. " +
+					"By default queries are spread over all nodes.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"consistency": schema.StringAttribute{
+				MarkdownDescription: "Consistency of every query the provider runs, such as `QUORUM`, `LOCAL_QUORUM`, or `ONE`. " +
+					"On a multi-node cluster, a consistency that makes writes and the reads following them overlap, such as `QUORUM`, keeps a plan from showing changes that were just applied. Default is `QUORUM`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("ANY", "ONE", "TWO", "THREE", "QUORUM", "ALL", "LOCAL_QUORUM", "EACH_QUORUM", "LOCAL_ONE"),
+				},
+			},
+			"read_consistency_fallback": schema.StringAttribute{
+				MarkdownDescription: "Consistency to retry data source reads at when not enough replicas answer at the configured consistency, e.g. `LOCAL_ONE` while a node is down. " +
+					"The data source then warns that its result may be stale. Resources never fall back. Disabled by default.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("ONE", "TWO", "THREE", "LOCAL_ONE", "QUORUM", "LOCAL_QUORUM"),
+				},
+			},
+			"driver_log_level": schema.StringAttribute{
+				MarkdownDescription: "Level of the gocql driver's internal logging, which is routed into the Terraform log. " +
+					"One of `none`, `error`, `warn`, `info`, or `debug`. Default is `warn`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("none", "error", "warn", "info", "debug"),
+				},
+			},
+			"trace_queries": schema.BoolAttribute{
+				MarkdownDescription: "Log every attempt of a schema or data changing statement, with its host, attempt number, and duration, to the Terraform log. " +
+					"String literals in statements are redacted. Default is `false`.",
+				Optional: true,
+			},
+			"pin_writes_to_coordinator": schema.BoolAttribute{
+				MarkdownDescription: "Run all schema, role, and grant changes on a single coordinator, the first contact point that is up, " +
+					"so that later statements do not race changes that other nodes have not applied yet. Reads keep using the load balancing policy. " +
+					"This is an advanced setting for large clusters. Default is `false`.",
+				Optional: true,
+			},
+			"idempotent_ddl": schema.BoolAttribute{
+				MarkdownDescription: "Whether creating and dropping keyspaces, tables, and roles uses `IF NOT EXISTS` and `IF EXISTS`. " +
+					"With `true`, creating an object that already exists adopts it and dropping one that is already gone succeeds; with `false`, both fail. " +
+					"When unset, dropping keyspaces and tables is idempotent, while creating keyspaces, tables, and roles and dropping roles fail on conflict.",
+				Optional: true,
+			},
+			"require_destroy_confirmation": schema.BoolAttribute{
+				MarkdownDescription: "Only delete resources when the `SCYLLADB_CONFIRM_DESTROY` environment variable is set to `true`. Other operations are not affected. Default is `false`.",
+				Optional:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"auth_login_userpass": schema.SingleNestedBlock{
@@ -103,19 +331,48 @@ func (p *scylladbProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Description: "Login to ScyllaDB using TLS",
 				Attributes: map[string]schema.Attribute{
 					"cert_file": schema.StringAttribute{
-						Description: "Path to the client certificate file for TLS connections",
+						Description: "Path to the client certificate file for TLS connections. Any intermediate certificates must follow the client certificate, in order up to the CA",
 						Optional:    true,
 					},
 					"key_file": schema.StringAttribute{
 						Description: "Path to the client key file for TLS connections",
 						Optional:    true,
 					},
+					"cert_base64": schema.StringAttribute{
+						Description: "Base64-encoded PEM client certificate for TLS connections. Mutually exclusive with cert_file",
+						Optional:    true,
+					},
+					"key_base64": schema.StringAttribute{
+						Description: "Base64-encoded PEM client key for TLS connections. Mutually exclusive with key_file",
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"host_filter": schema.SingleNestedBlock{
+				MarkdownDescription: "Restrict the hosts the provider connects to. Exactly one of `hosts` or `datacenter` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"hosts": schema.ListAttribute{
+						MarkdownDescription: "Hostnames or IP addresses the provider is allowed to connect to. When a proxy is used, these are matched against `host`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"datacenter": schema.StringAttribute{
+						Description: "Name of the datacenter the provider is allowed to connect to",
+						Optional:    true,
+					},
 				},
 			},
 		},
 	}
 }
 
+func (p *scylladbProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		authMethodValidator{},
+	}
+}
+
 func (p *scylladbProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Scylla client")
 
@@ -129,21 +386,27 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
-	host := os.Getenv("SCYLLADB_HOST")
-
-	if !data.Host.IsNull() {
-		host = data.Host.ValueString()
+	var hosts []string
+	switch {
+	case !data.Host.IsNull():
+		hosts = []string{data.Host.ValueString()}
+	case !data.Hosts.IsNull():
+		resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+	case os.Getenv("SCYLLADB_HOST") != "":
+		hosts = []string{os.Getenv("SCYLLADB_HOST")}
+	default:
+		hosts = hostsFromEnv(os.Getenv("SCYLLADB_HOSTS"))
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	if len(hosts) == 0 || slices.Contains(hosts, "") {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing ScyllaDB Host",
 			"The provider cannot create the ScyllaDB client as there is a missing or empty value for the ScyllaDB host. "+
-				"Set the host value in the configuration or use the SCYLLADB_HOST environment variable. "+
+				"Set the host or hosts value in the configuration or use the SCYLLADB_HOST or SCYLLADB_HOSTS environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -152,11 +415,19 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	ctx = tflog.SetField(ctx, "scylladb_host", host)
+	port := defaultPort
+	if !data.Port.IsNull() {
+		port = int(data.Port.ValueInt64())
+	}
+	for i, host := range hosts {
+		hosts[i] = hostWithPort(host, port)
+	}
+
+	ctx = tflog.SetField(ctx, "scylladb_host", strings.Join(hosts, ","))
 	tflog.Debug(ctx, "Creating scylladb client")
 
 	// Create a new scylladb client using the config
-	client, err := scylladb.NewClusterConfig([]string{host})
+	client, err := scylladb.NewClusterConfig(hosts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create a Cluster Configuration",
@@ -176,6 +447,177 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 		client.SetSystemAuthKeyspace("system")
 	}
 
+	client.RequireDestroyConfirmation = data.RequireDestroyConfirmation.ValueBool()
+	if !data.IdempotentDDL.IsNull() {
+		client.DDLMode = scylladb.DDLModeStrict
+		if data.IdempotentDDL.ValueBool() {
+			client.DDLMode = scylladb.DDLModeIdempotent
+		}
+	}
+	client.SetPinWritesToCoordinator(data.PinWritesToCoordinator.ValueBool())
+
+	// Set the query timeouts, defaulting to environment variables
+	requestTimeoutValue := os.Getenv("SCYLLADB_REQUEST_TIMEOUT")
+	if !data.RequestTimeout.IsNull() {
+		requestTimeoutValue = data.RequestTimeout.ValueString()
+	}
+	requestTimeout := scylladb.DefaultRequestTimeout
+	if requestTimeoutValue != "" {
+		requestTimeout, err = time.ParseDuration(requestTimeoutValue)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				"The request timeout must be a valid duration such as `30s`.\n\n"+err.Error(),
+			)
+		}
+	}
+	ddlTimeout := scylladb.DefaultDDLTimeout
+	if !data.DDLTimeout.IsNull() {
+		ddlTimeout, err = time.ParseDuration(data.DDLTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ddl_timeout"),
+				"Invalid DDL Timeout",
+				"The DDL timeout must be a valid duration such as `2m`.\n\n"+err.Error(),
+			)
+		}
+	}
+	client.SetTimeouts(requestTimeout, ddlTimeout)
+	connectTimeout := os.Getenv("SCYLLADB_CONNECT_TIMEOUT")
+	if !data.ConnectTimeout.IsNull() {
+		connectTimeout = data.ConnectTimeout.ValueString()
+	}
+	if connectTimeout != "" {
+		timeout, err := time.ParseDuration(connectTimeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("connect_timeout"),
+				"Invalid Connect Timeout",
+				"The connect timeout must be a valid duration such as `5s`.\n\n"+err.Error(),
+			)
+		} else {
+			client.SetConnectTimeout(timeout)
+		}
+	}
+	if !data.MaxIdleTime.IsNull() {
+		maxIdleTime, err := time.ParseDuration(data.MaxIdleTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_idle_time"),
+				"Invalid Max Idle Time",
+				"The max idle time must be a valid duration such as `5m`.\n\n"+err.Error(),
+			)
+		}
+		client.SetMaxIdleTime(maxIdleTime)
+	}
+	client.SetFailoverContactPoints(data.FailoverContactPoints.ValueBool())
+	client.SetRetryPolicy(int(data.RetryAttempts.ValueInt64()), data.RetryBackoff.ValueBool())
+	if !data.LocalDatacenter.IsNull() {
+		client.SetLocalDatacenter(data.LocalDatacenter.ValueString())
+	}
+	if !data.Consistency.IsNull() {
+		if err := client.SetConsistency(data.Consistency.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("consistency"),
+				"Invalid Consistency",
+				err.Error(),
+			)
+		}
+	}
+	if err := client.SetReadConsistencyFallback(data.ReadConsistencyFallback.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_consistency_fallback"),
+			"Invalid Read Consistency Fallback",
+			err.Error(),
+		)
+	}
+	if !data.GrantVerifyAttempts.IsNull() {
+		client.GrantVerifyAttempts = int(data.GrantVerifyAttempts.ValueInt64())
+	}
+	if !data.ConnectConcurrency.IsNull() {
+		client.SetConnectConcurrency(int(data.ConnectConcurrency.ValueInt64()))
+	}
+	if !data.NumConns.IsNull() {
+		client.SetNumConns(int(data.NumConns.ValueInt64()))
+	}
+	if !data.DisableInitialHostLookup.IsNull() {
+		if err := client.SetDisableInitialHostLookup(data.DisableInitialHostLookup.ValueBool()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("disable_initial_host_lookup"),
+				"Invalid Host Lookup Setting",
+				err.Error(),
+			)
+		}
+	}
+
+	// Route the driver's logging into tflog
+	driverLogLevel := defaultDriverLogLevel
+	if !data.DriverLogLevel.IsNull() {
+		driverLogLevel = data.DriverLogLevel.ValueString()
+	}
+	client.Cluster.Logger = newTflogLogger(ctx, driverLogLevel)
+	if data.TraceQueries.ValueBool() {
+		client.Cluster.QueryObserver = newTflogQueryObserver(ctx)
+	}
+
+	// Set the dial settings for direct connections if configured
+	if !data.DialTimeout.IsNull() || !data.LocalAddr.IsNull() || !data.DualStack.IsNull() {
+		dialOptions := scylladb.DialOptions{
+			LocalAddr:        data.LocalAddr.ValueString(),
+			DisableDualStack: !data.DualStack.IsNull() && !data.DualStack.ValueBool(),
+		}
+		if !data.DialTimeout.IsNull() {
+			dialOptions.Timeout, err = time.ParseDuration(data.DialTimeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("dial_timeout"),
+					"Invalid Dial Timeout",
+					"The dial timeout must be a valid duration such as `5s`.\n\n"+err.Error(),
+				)
+			}
+		}
+		if err := client.SetDialer(dialOptions); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Configure Dial Settings",
+				"An unexpected error was encountered trying to configure the dial settings. "+
+					"Please verify `dial_timeout`, `local_addr`, and `dual_stack` and try again.\n\n"+
+					err.Error(),
+			)
+		}
+	}
+
+	// Set the host filter if configured
+	if data.HostFilter != nil {
+		hasHosts := !data.HostFilter.Hosts.IsNull()
+		hasDatacenter := !data.HostFilter.Datacenter.IsNull()
+		switch {
+		case hasHosts && hasDatacenter, !hasHosts && !hasDatacenter:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host_filter"),
+				"Invalid Host Filter Configuration",
+				"Exactly one of `hosts` or `datacenter` must be set in `host_filter`.",
+			)
+		case hasHosts:
+			var hosts []string
+			resp.Diagnostics.Append(data.HostFilter.Hosts.ElementsAs(ctx, &hosts, false)...)
+			for i, host := range hosts {
+				hosts[i] = hostWithPort(host, port)
+			}
+			if err := client.SetHostFilterHosts(hosts); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("host_filter").AtName("hosts"),
+					"Unable to Configure Host Filter",
+					"An unexpected error was encountered trying to configure the host filter. "+
+						"Please verify the hosts can be resolved and try again.\n\n"+
+						err.Error(),
+				)
+			}
+		default:
+			client.SetHostFilterDatacenter(data.HostFilter.Datacenter.ValueString())
+		}
+	}
+
 	// Set Username/Password authentication if configured
 	if data.AuthLoginUserPass != nil {
 		tflog.Debug(ctx, "Configuring Username/Password authentication for ScyllaDB client")
@@ -217,19 +659,31 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 		"client_key_len":  len(os.Getenv("SCYLLADB_CLIENT_KEY")),
 	})
 
-	// Read the CA cert — mutually exclusive: ca_cert (inline) vs ca_cert_file
-	if !data.CAcert.IsNull() && !data.CAcertFile.IsNull() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("ca_cert"),
-			"Conflicting CA Certificate Configuration",
-			"Only one of `ca_cert` or `ca_cert_file` may be set, not both.",
-		)
-		resp.Diagnostics.AddAttributeError(
-			path.Root("ca_cert_file"),
-			"Conflicting CA Certificate Configuration",
-			"Only one of `ca_cert` or `ca_cert_file` may be set, not both.",
-		)
-		return // fail fast if both are set, as this is a configuration error that must be resolved by the user and there is no value in proceeding with further configuration attempts
+	// Read the CA cert — mutually exclusive: ca_cert (inline) vs ca_cert_file vs ca_cert_base64
+	var caCertSources []path.Path
+	for _, source := range []struct {
+		attribute string
+		value     types.String
+	}{
+		{"ca_cert", data.CAcert},
+		{"ca_cert_file", data.CAcertFile},
+		{"ca_cert_base64", data.CAcertBase64},
+	} {
+		if !source.value.IsNull() {
+			caCertSources = append(caCertSources, path.Root(source.attribute))
+		}
+	}
+	if len(caCertSources) > 1 {
+		for _, source := range caCertSources {
+			resp.Diagnostics.AddAttributeError(
+				source,
+				"Conflicting CA Certificate Configuration",
+				"Only one of `ca_cert`, `ca_cert_file`, or `ca_cert_base64` may be set.",
+			)
+		}
+		return // fail fast if several are set, as this is a configuration error that must be resolved by the user and there is no value in proceeding with further configuration attempts
+	} else if !data.CAcertBase64.IsNull() {
+		caCert = decodeBase64PEM(data.CAcertBase64, path.Root("ca_cert_base64"), "CA certificate", &resp.Diagnostics)
 	} else if !data.CAcert.IsNull() {
 		caCert = []byte(data.CAcert.ValueString())
 	} else if !data.CAcertFile.IsNull() {
@@ -246,6 +700,38 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	// The certificates of ca_cert_dir are trusted along with any of the sources above
+	if !data.CAcertDir.IsNull() {
+		dirCerts, skipped, err := scylladb.ReadCACertDir(data.CAcertDir.ValueString())
+		for _, skip := range skipped {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("ca_cert_dir"),
+				"Skipped CA Certificate File",
+				"A file in the CA certificate directory was skipped.\n\n"+skip.Error(),
+			)
+		}
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_dir"),
+				"Unable to Read CA Certificate Directory",
+				"An unexpected error was encountered trying to read the CA certificate directory. "+
+					"Please verify the directory path is correct and try again.\n\n"+
+					err.Error(),
+			)
+		case len(dirCerts) == 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_dir"),
+				"No CA Certificates Found",
+				fmt.Sprintf("The directory %q contains no .pem or .crt file with a PEM-encoded certificate.", data.CAcertDir.ValueString()),
+			)
+		case len(caCert) > 0:
+			caCert = append(append(bytes.TrimSpace(caCert), '\n'), dirCerts...)
+		default:
+			caCert = dirCerts
+		}
+	}
+
 	// Read the skip host verification flag
 	if !data.SkipHostVerification.IsNull() {
 		skipHostVerification = data.SkipHostVerification.ValueBool()
@@ -253,6 +739,33 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Read the client cert and key files if provided
 	if data.AuthTLS != nil {
+		for _, conflict := range []struct {
+			file, encoded string
+			fileValue     types.String
+			encodedValue  types.String
+		}{
+			{"cert_file", "cert_base64", data.AuthTLS.CertFile, data.AuthTLS.CertBase64},
+			{"key_file", "key_base64", data.AuthTLS.KeyFile, data.AuthTLS.KeyBase64},
+		} {
+			if !conflict.fileValue.IsNull() && !conflict.encodedValue.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("auth_tls").AtName(conflict.encoded),
+					"Conflicting Client Certificate Configuration",
+					fmt.Sprintf("Only one of `%s` or `%s` may be set, not both.", conflict.file, conflict.encoded),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !data.AuthTLS.CertBase64.IsNull() {
+			clientCert = decodeBase64PEM(data.AuthTLS.CertBase64, path.Root("auth_tls").AtName("cert_base64"), "client certificate", &resp.Diagnostics)
+		}
+		if !data.AuthTLS.KeyBase64.IsNull() {
+			clientKey = decodeBase64PEM(data.AuthTLS.KeyBase64, path.Root("auth_tls").AtName("key_base64"), "client key", &resp.Diagnostics)
+		}
+
 		if !data.AuthTLS.CertFile.IsNull() {
 			certFile := data.AuthTLS.CertFile.ValueString()
 			clientCert, err = os.ReadFile(certFile)
@@ -295,12 +808,56 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	if !data.TLSDisableSessionTickets.IsNull() || !data.TLSRenegotiation.IsNull() {
+		err = client.SetTLSOptions(scylladb.TLSOptions{
+			DisableSessionTickets: data.TLSDisableSessionTickets.ValueBool(),
+			Renegotiation:         data.TLSRenegotiation.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid TLS Settings",
+				"`tls_disable_session_tickets` and `tls_renegotiation` require TLS, configured with `ca_cert`, `ca_cert_file`, `ca_cert_base64`, `ca_cert_dir`, "+
+					"or the SCYLLADB_CA_CERT environment variable.\n\n"+
+					err.Error(),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	logEffectiveConfig(ctx, client)
+
 	err = client.CreateSession()
-	if err != nil {
+	switch {
+	case errors.Is(err, scylladb.ErrAuthFailed):
+		resp.Diagnostics.AddError(
+			"ScyllaDB Authentication Failed",
+			"The cluster was reached but rejected the credentials. "+
+				"Please verify the username and password in `auth_login_userpass` or the client certificate in `auth_tls`. "+
+				"Retrying will not help until the credentials are fixed.\n\n"+
+				err.Error(),
+		)
+		return
+	case errors.Is(err, scylladb.ErrTLS):
+		resp.Diagnostics.AddError(
+			"ScyllaDB TLS Handshake Failed",
+			"The cluster was reached but the TLS handshake failed. "+
+				"Please verify that the server uses TLS, that `ca_cert`, `ca_cert_file`, `ca_cert_base64`, or `ca_cert_dir` contains the CA that issued the server certificate, "+
+				"and that the host name matches the certificate or `skip_host_verification` is set.\n\n"+
+				err.Error(),
+		)
+		return
+	case errors.Is(err, scylladb.ErrUnreachable):
+		resp.Diagnostics.AddError(
+			"ScyllaDB Host Unreachable",
+			"No connection could be made to the ScyllaDB hosts. "+
+				"Please verify the host and port, the proxy settings, and that the cluster is running and reachable from this machine.\n\n"+
+				err.Error(),
+		)
+		return
+	case err != nil:
 		resp.Diagnostics.AddError(
 			"Unable to Create ScyllaDB Client",
 			"An unexpected error was encountered trying to create the ScyllaDB client. "+
@@ -314,28 +871,86 @@ func (p *scylladbProvider) Configure(ctx context.Context, req provider.Configure
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured ScyllaDB client", map[string]any{"success": true})
 }
 
+// hostsFromEnv splits the comma-separated hosts of the SCYLLADB_HOSTS environment variable,
+// ignoring blanks around and between them.
+func hostsFromEnv(value string) []string {
+	var hosts []string
+	for _, host := range strings.Split(value, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// defaultPort is the CQL port of hosts given without one when the port attribute is not set.
+const defaultPort = 9042
+
+// hostWithPort returns host with port appended when it does not include one, bracketing IPv6
+// addresses. Unix socket hosts are returned unchanged.
+func hostWithPort(host string, port int) string {
+	if scylladb.IsUnixSocketHost(host) {
+		return host
+	}
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"), strconv.Itoa(port))
+}
+
+// decodeBase64PEM decodes a base64-encoded PEM attribute, adding an attribute error naming what
+// the value was meant to hold when it cannot be decoded.
+func decodeBase64PEM(value types.String, attribute path.Path, what string, diags *diag.Diagnostics) []byte {
+	decoded, err := scylladb.DecodeBase64PEM(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Base64-Encoded PEM",
+			fmt.Sprintf("The %s must be a base64-encoded PEM document, e.g. the output of `base64 -w0 cert.pem`.\n\n%s", what, err),
+		)
+	}
+	return decoded
+}
+
+// logEffectiveConfig logs the final cluster configuration at debug level so connection issues
+// can be diagnosed from TF_LOG=DEBUG output.
+func logEffectiveConfig(ctx context.Context, client *scylladb.Cluster) {
+	tflog.Debug(ctx, "Effective ScyllaDB cluster configuration", client.EffectiveConfig())
+}
+
 func (p *scylladbProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRoleResource,
 		NewGrantResource,
 		NewKeyspaceGrantsResource,
 		NewTableGrantsResource,
+		NewRoleGrantsResource,
+		NewSchemaResource,
+		NewKeyspaceResource,
 	}
 }
 
 func (p *scylladbProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
-		//NewExampleEphemeralResource,
+		NewClientCertificateEphemeralResource,
 	}
 }
 
 func (p *scylladbProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRoleDataSource,
+		NewRolesDataSource,
+		NewGrantsDataSource,
+		NewKeyspaceDataSource,
+		NewKeyspacesDataSource,
+		NewPrivilegeExpansionDataSource,
+		NewHCLExportDataSource,
+		NewProviderMetricsDataSource,
 	}
 }
 
@@ -347,7 +962,9 @@ func (p *scylladbProvider) Functions(ctx context.Context) []func() function.Func
 
 func (p *scylladbProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
-		//NewExampleAction,
+		NewVerifyLoginAction,
+		NewInvalidatePermissionsCacheAction,
+		NewPreflightAction,
 	}
 }
 
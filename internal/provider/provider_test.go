@@ -3,15 +3,22 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
 
 const (
@@ -195,3 +202,236 @@ data "scylladb_role" "cassandra" {
 		},
 	})
 }
+
+func TestAccProviderConfigmTLSBase64(t *testing.T) {
+	caCert, err := testutil.GenerateTestCACert()
+	if err != nil {
+		t.Fatalf("failed to generate CA certificate: %s", err)
+	}
+	serverCert, err := testutil.GenerateCert(caCert, testutil.CertSubject{
+		CommonName:      "scylla-server",
+		Organization:    []string{"My Org, Inc."},
+		Country:         []string{"US"},
+		DNSNames:        []string{"scylla-server"},
+		DurationInYears: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to generate server certificate: %s", err)
+	}
+	clientCert, err := testutil.GenerateCert(caCert, testutil.CertSubject{
+		CommonName:      "cassandra",
+		Organization:    []string{"My Org, Inc."},
+		Country:         []string{"US"},
+		DNSNames:        []string{"scylla-client"},
+		DurationInYears: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to generate client certificate: %s", err)
+	}
+	caCertPEM, _, err := caCert.PEMEncodedCert()
+	if err != nil {
+		t.Fatalf("failed to get CA PEM encoded cert: %s", err.Error())
+	}
+	clientCertPEM, clientKeyPEM, err := clientCert.PEMEncodedCert()
+	if err != nil {
+		t.Fatalf("failed to get client PEM encoded cert: %s", err.Error())
+	}
+	host := testutil.NewTestScyllaContainerMTLS(t, caCert, serverCert)
+
+	// Pass the CA, client certificate, and key base64-encoded, as handed out by secret stores.
+	providerConfigBase64Fmt := `
+provider "scylladb" {
+  host                   = "%s"
+  ca_cert_base64         = %q
+  skip_host_verification = true
+  auth_tls {
+    cert_base64 = %q
+    key_base64  = %q
+  }
+}
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(providerConfigBase64Fmt, host,
+					base64.StdEncoding.EncodeToString(caCertPEM),
+					base64.StdEncoding.EncodeToString(clientCertPEM),
+					base64.StdEncoding.EncodeToString(clientKeyPEM),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigBase64Invalid(t *testing.T) {
+	caCert, err := testutil.GenerateTestCACert()
+	if err != nil {
+		t.Fatalf("failed to generate CA certificate: %s", err)
+	}
+	caCertPEM, _, err := caCert.PEMEncodedCert()
+	if err != nil {
+		t.Fatalf("failed to get CA PEM encoded cert: %s", err.Error())
+	}
+
+	configFmt := `
+provider "scylladb" {
+  host = "localhost:9042"
+  %s
+}
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+`
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Raw PEM passed where base64 is expected
+			{
+				Config:      fmt.Sprintf(configFmt, fmt.Sprintf("ca_cert_base64 = %q", string(caCertPEM))),
+				ExpectError: regexp.MustCompile(`Invalid Base64-Encoded PEM`),
+			},
+			// Valid base64 that does not decode to PEM
+			{
+				Config: fmt.Sprintf(configFmt, fmt.Sprintf("auth_tls {\n    cert_base64 = %q\n  }",
+					base64.StdEncoding.EncodeToString([]byte("not a certificate")))),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Base64-Encoded PEM.*not PEM-encoded`),
+			},
+			{
+				Config: fmt.Sprintf(configFmt, fmt.Sprintf("ca_cert = %q\n  ca_cert_base64 = %q",
+					string(caCertPEM), base64.StdEncoding.EncodeToString(caCertPEM))),
+				ExpectError: regexp.MustCompile(`Conflicting CA Certificate Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigTLSOptionsWithoutTLS(t *testing.T) {
+	t.Setenv("SCYLLADB_CA_CERT", "")
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "scylladb" {
+  host              = "localhost:9042"
+  tls_renegotiation = "once"
+}
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid TLS Settings`),
+			},
+		},
+	})
+}
+
+// TestAccProviderConfigHosts verifies that the provider connects through the other contact points
+// when the first one is down.
+func TestAccProviderConfigHosts(t *testing.T) {
+	host := testutil.NewTestContainer(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "scylladb" {
+  hosts = ["127.0.0.1:1", %q]
+  auth_login_userpass {
+    username = "cassandra"
+    password = "cassandra"
+  }
+}
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+`, host),
+				Check: resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "is_superuser", "true"),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigHostsConflict(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "scylladb" {
+  host  = "localhost:9042"
+  hosts = ["localhost:9042"]
+}
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestHostsFromEnv(t *testing.T) {
+	for value, want := range map[string][]string{
+		"":                               nil,
+		"10.0.0.1":                       {"10.0.0.1"},
+		"10.0.0.1:9042, 10.0.0.2:9042 ,": {"10.0.0.1:9042", "10.0.0.2:9042"},
+	} {
+		if got := hostsFromEnv(value); !slices.Equal(got, want) {
+			t.Errorf("hostsFromEnv(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestHostWithPort(t *testing.T) {
+	for host, want := range map[string]string{
+		"localhost":                       "localhost:9043",
+		"localhost:9042":                  "localhost:9042",
+		"10.0.0.1":                        "10.0.0.1:9043",
+		"::1":                             "[::1]:9043",
+		"[::1]":                           "[::1]:9043",
+		"[fe80::1]:9042":                  "[fe80::1]:9042",
+		"unix:///var/run/scylla/cql.sock": "unix:///var/run/scylla/cql.sock",
+	} {
+		if got := hostWithPort(host, 9043); got != want {
+			t.Errorf("hostWithPort(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestLogEffectiveConfig(t *testing.T) {
+	client, err := scylladb.NewClusterConfig([]string{"localhost:9042"})
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	client.SetUserPasswordAuth("cassandra", "s3cret")
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	logEffectiveConfig(ctx, client)
+
+	if strings.Contains(output.String(), "s3cret") {
+		t.Errorf("log output contains the password: %s", output.String())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry["@level"] != "debug" {
+		t.Errorf("expected debug level, got %v", entry["@level"])
+	}
+	for _, field := range []string{"hosts", "num_conns", "consistency", "tls", "proxy", "auth", "username"} {
+		if _, ok := entry[field]; !ok {
+			t.Errorf("expected field %q in log entry: %v", field, entry)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// mutatingStatementPrefixes are the leading keywords of statements that change the cluster.
+var mutatingStatementPrefixes = []string{
+	"ALTER", "CREATE", "DELETE", "DROP", "GRANT", "INSERT", "REVOKE", "TRUNCATE", "UPDATE",
+}
+
+// cqlStringLiteral matches single-quoted CQL string literals, including escaped quotes.
+var cqlStringLiteral = regexp.MustCompile(`'(?:[^']|'')*'`)
+
+// tflogQueryObserver logs each attempt of a mutating statement with the host it ran on, so
+// retries and failovers (for example through a flaky proxy) show up in Terraform's log. Bound
+// values are never logged and string literals are redacted, since they may hold passwords.
+type tflogQueryObserver struct {
+	ctx context.Context
+}
+
+var _ gocql.QueryObserver = &tflogQueryObserver{}
+
+func newTflogQueryObserver(ctx context.Context) *tflogQueryObserver {
+	return &tflogQueryObserver{
+		ctx: tflog.SetField(ctx, "component", "gocql"),
+	}
+}
+
+func (o *tflogQueryObserver) ObserveQuery(_ context.Context, q gocql.ObservedQuery) {
+	if !isMutatingStatement(q.Statement) {
+		return
+	}
+
+	fields := map[string]any{
+		"statement":   redactStatement(q.Statement),
+		"attempt":     q.Attempt + 1,
+		"duration_ms": q.End.Sub(q.Start).Milliseconds(),
+	}
+	if q.Host != nil {
+		fields["host"] = q.Host.ConnectAddressAndPort()
+	}
+	if q.Err != nil {
+		fields["error"] = q.Err.Error()
+		tflog.Warn(o.ctx, "Query attempt failed", fields)
+		return
+	}
+	tflog.Info(o.ctx, "Query attempt succeeded", fields)
+}
+
+func isMutatingStatement(statement string) bool {
+	statement = strings.ToUpper(strings.TrimSpace(statement))
+	for _, prefix := range mutatingStatementPrefixes {
+		if strings.HasPrefix(statement, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactStatement(statement string) string {
+	return cqlStringLiteral.ReplaceAllString(strings.TrimSpace(statement), "'<redacted>'")
+}
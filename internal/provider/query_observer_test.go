@@ -0,0 +1,71 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestTflogQueryObserver(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	host, err := gocql.NewHostInfoFromAddrPort(net.ParseIP("10.0.0.1"), 9042)
+	if err != nil {
+		t.Fatalf("failed to create host info: %s", err)
+	}
+
+	observer := newTflogQueryObserver(ctx)
+	start := time.Now()
+	statement := `ALTER ROLE 'app' WITH PASSWORD = 's3cret''s'`
+	// The first attempt times out and the retry succeeds on the same host
+	observer.ObserveQuery(ctx, gocql.ObservedQuery{
+		Statement: statement,
+		Values:    []any{"bound-s3cret"},
+		Start:     start,
+		End:       start.Add(2 * time.Second),
+		Host:      host,
+		Err:       errors.New("gocql: no response received from cassandra within timeout period"),
+		Attempt:   0,
+	})
+	observer.ObserveQuery(ctx, gocql.ObservedQuery{
+		Statement: statement,
+		Values:    []any{"bound-s3cret"},
+		Start:     start.Add(2 * time.Second),
+		End:       start.Add(2*time.Second + 5*time.Millisecond),
+		Host:      host,
+		Attempt:   1,
+	})
+	// Reads are not traced
+	observer.ObserveQuery(ctx, gocql.ObservedQuery{Statement: "SELECT role FROM system.roles", Host: host})
+
+	if strings.Contains(output.String(), "s3cret") {
+		t.Fatalf("expected secrets to be redacted, got: %s", output.String())
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %v", len(entries), entries)
+	}
+
+	failed, retried := entries[0], entries[1]
+	if failed["@level"] != "warn" || failed["attempt"] != float64(1) || failed["error"] == nil {
+		t.Errorf("unexpected failed attempt entry: %v", failed)
+	}
+	if retried["@level"] != "info" || retried["attempt"] != float64(2) || retried["host"] != "10.0.0.1:9042" {
+		t.Errorf("unexpected retried attempt entry: %v", retried)
+	}
+	if retried["statement"] != `ALTER ROLE '<redacted>' WITH PASSWORD = '<redacted>'` {
+		t.Errorf("unexpected statement in entry: %v", retried["statement"])
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// readWithFallback runs read against the client, retrying it at read_consistency_fallback when the
+// configured consistency times out. A warning is added to diags when the fallback was used, since
+// the result may be stale; data sources reading several times get a single warning.
+func readWithFallback[T any](client *scylladb.Cluster, diags *diag.Diagnostics, read func(*scylladb.Cluster) (T, error)) (T, error) {
+	var result T
+	fellBack, err := client.ReadWithFallback(func(c *scylladb.Cluster) error {
+		var err error
+		result, err = read(c)
+		return err
+	})
+	if fellBack {
+		diags.Append(diag.NewWarningDiagnostic(
+			"Read at Fallback Consistency",
+			"Not enough replicas answered at the configured consistency, so the data was read at `read_consistency_fallback` instead. "+
+				"The result may be stale.",
+		))
+	}
+	return result, err
+}
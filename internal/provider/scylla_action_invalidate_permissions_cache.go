@@ -0,0 +1,107 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &invalidatePermissionsCacheAction{}
+	_ action.ActionWithConfigure = &invalidatePermissionsCacheAction{}
+)
+
+// NewInvalidatePermissionsCacheAction is a helper function to simplify the provider implementation.
+func NewInvalidatePermissionsCacheAction() action.Action {
+	return &invalidatePermissionsCacheAction{}
+}
+
+// invalidatePermissionsCacheAction is the action implementation.
+type invalidatePermissionsCacheAction struct {
+	client *scylladb.Cluster
+}
+
+// invalidatePermissionsCacheActionModel maps the action schema data.
+type invalidatePermissionsCacheActionModel struct {
+	Role types.String `tfsdk:"role"`
+}
+
+// Metadata returns the action type name.
+func (a *invalidatePermissionsCacheAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalidate_permissions_cache"
+}
+
+// Schema defines the schema for the action.
+func (a *invalidatePermissionsCacheAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until changed grants of a role take effect for existing sessions, then re-reads the grants of the role.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "The role whose grants changed.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke waits out the permissions cache and reports the grants the role holds afterwards.
+func (a *invalidatePermissionsCacheAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config invalidatePermissionsCacheActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := config.Role.ValueString()
+	_, grants, err := a.client.WithContext(ctx).AwaitPermissionsCacheRefresh(role, func(validity time.Duration) error {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Waiting %s for cached permissions of %s to expire", validity, role),
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(validity):
+			return nil
+		}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Permissions",
+			fmt.Sprintf("The permissions cache of the role %q could not be waited out.\n\n%s", role, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Permissions of %s are in effect: %d grants", role, len(grants)),
+	})
+}
+
+// Configure adds the provider configured client to the action.
+func (a *invalidatePermissionsCacheAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
@@ -0,0 +1,92 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &preflightAction{}
+	_ action.ActionWithConfigure = &preflightAction{}
+)
+
+// NewPreflightAction is a helper function to simplify the provider implementation.
+func NewPreflightAction() action.Action {
+	return &preflightAction{}
+}
+
+// preflightAction is the action implementation.
+type preflightAction struct {
+	client *scylladb.Cluster
+}
+
+// Metadata returns the action type name.
+func (a *preflightAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preflight"
+}
+
+// Schema defines the schema for the action.
+func (a *preflightAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks that the cluster is reachable, that the system auth keyspace holds the roles, and that authorization is enabled. " +
+			"It only reads from the cluster.",
+	}
+}
+
+// Invoke runs the checks and reports a summary. Failed checks are reported as errors.
+func (a *preflightAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	report, err := a.client.WithContext(ctx).Preflight()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Preflight Check Failed",
+			fmt.Sprintf("The cluster could not be checked.\n\n%s", err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Connected to release %s in %s; datacenters: %s", report.ReleaseVersion, report.Latency, strings.Join(report.Datacenters, ", ")),
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("System auth keyspace %s: roles table found = %t", report.AuthKeyspace, report.AuthKeyspaceReady),
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Authenticator: %s; authorizer: %s; authorization enabled = %t", report.Authenticator, report.Authorizer, report.AuthorizationEnabled()),
+	})
+
+	if problems := report.Problems(); len(problems) > 0 {
+		resp.Diagnostics.AddError(
+			"Preflight Check Failed",
+			"The cluster is reachable, but it is not ready to be managed:\n\n- "+strings.Join(problems, "\n- "),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *preflightAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
@@ -0,0 +1,101 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &verifyLoginAction{}
+	_ action.ActionWithConfigure = &verifyLoginAction{}
+)
+
+// NewVerifyLoginAction is a helper function to simplify the provider implementation.
+func NewVerifyLoginAction() action.Action {
+	return &verifyLoginAction{}
+}
+
+// verifyLoginAction is the action implementation.
+type verifyLoginAction struct {
+	client *scylladb.Cluster
+}
+
+// verifyLoginActionModel maps the action schema data.
+type verifyLoginActionModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// Metadata returns the action type name.
+func (a *verifyLoginAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verify_login"
+}
+
+// Schema defines the schema for the action.
+func (a *verifyLoginAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Verifies that a role can log in with the given credentials. The provider's own session is not affected.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "The name of the role to log in as.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the role. Ephemeral values are accepted.",
+				Required:    true,
+				WriteOnly:   true,
+			},
+		},
+	}
+}
+
+// Invoke logs in with the given credentials and reports the outcome.
+func (a *verifyLoginAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config verifyLoginActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := config.Username.ValueString()
+	if err := a.client.WithContext(ctx).VerifyLogin(username, config.Password.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Login Verification Failed",
+			fmt.Sprintf("The role %q could not log in with the given credentials.\n\n%s", username, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Verified that %s can log in", username),
+	})
+}
+
+// Configure adds the provider configured client to the action.
+func (a *verifyLoginAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
@@ -6,8 +6,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
@@ -35,6 +38,14 @@ type roleDataSourceModel struct {
 	CanLogin    types.Bool     `tfsdk:"can_login"`
 	IsSuperuser types.Bool     `tfsdk:"is_superuser"`
 	MemberOf    []types.String `tfsdk:"member_of"`
+	Members     []types.String `tfsdk:"members"`
+	HasPassword types.Bool     `tfsdk:"has_password"`
+
+	ResourceType         types.String `tfsdk:"resource_type"`
+	Keyspace             types.String `tfsdk:"keyspace"`
+	Identifier           types.String `tfsdk:"identifier"`
+	EffectivePermissions types.List   `tfsdk:"effective_permissions"`
+	AccessByKeyspace     types.Map    `tfsdk:"access_by_keyspace"`
 }
 
 // Metadata returns the data source type name.
@@ -64,9 +75,55 @@ func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			},
 			"member_of": schema.ListAttribute{
 				Computed:    true,
-				Description: "a list of members of the role",
+				Description: "a list of roles the role is a member of",
 				ElementType: types.StringType,
 			},
+			"members": schema.ListAttribute{
+				Computed:    true,
+				Description: "a list of roles that are members of the role",
+				ElementType: types.StringType,
+			},
+			"has_password": schema.BoolAttribute{
+				Computed:    true,
+				Description: "whether the role has a password set. The password hash itself is never exposed",
+			},
+			"resource_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of resource to read the effective permissions of the role on (e.g., ALL KEYSPACES, KEYSPACE, TABLE).",
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(
+						"ALL KEYSPACES",
+						"KEYSPACE",
+						"TABLE",
+					),
+				},
+			},
+			"keyspace": schema.StringAttribute{
+				Optional:    true,
+				Description: "The keyspace of the resource.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("resource_type")),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "The identifier of the resource (e.g., table name).",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("keyspace")),
+				},
+			},
+			"effective_permissions": schema.ListAttribute{
+				Computed: true,
+				Description: "The privileges the role holds on the resource when resource_type is set, sorted. " +
+					"Includes privileges inherited from the roles it is a member of and privileges granted on enclosing resources",
+				ElementType: types.StringType,
+			},
+			"access_by_keyspace": schema.MapAttribute{
+				Computed: true,
+				Description: "The privileges granted directly to the role on each keyspace, sorted. " +
+					"Privileges granted on a table are listed under its keyspace; inherited privileges and grants on ALL KEYSPACES are not included",
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
 		},
 	}
 }
@@ -81,7 +138,9 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	curRole, err := d.client.GetRole(config.ID.ValueString())
+	curRole, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) (scylladb.Role, error) {
+		return c.GetRole(config.ID.ValueString())
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read the role",
@@ -92,15 +151,74 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Map response body to model.
 	state := roleDataSourceModel{
-		ID:          config.ID,
-		Role:        types.StringValue(curRole.Role),
-		CanLogin:    types.BoolValue(curRole.CanLogin),
-		IsSuperuser: types.BoolValue(curRole.IsSuperuser),
+		ID:                   config.ID,
+		Role:                 types.StringValue(curRole.Role),
+		CanLogin:             types.BoolValue(curRole.CanLogin),
+		IsSuperuser:          types.BoolValue(curRole.IsSuperuser),
+		HasPassword:          types.BoolValue(curRole.HasPassword),
+		ResourceType:         config.ResourceType,
+		Keyspace:             config.Keyspace,
+		Identifier:           config.Identifier,
+		EffectivePermissions: types.ListNull(types.StringType),
 	}
 	for _, member := range curRole.MemberOf {
 		state.MemberOf = append(state.MemberOf, types.StringValue(member))
 	}
 
+	members, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) ([]string, error) {
+		return c.GetRoleMembers(curRole.Role)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the role members",
+			err.Error(),
+		)
+		return
+	}
+	for _, member := range members {
+		state.Members = append(state.Members, types.StringValue(member))
+	}
+
+	grants, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) ([]scylladb.Grant, error) {
+		return c.ListAllGrants(curRole.Role)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the grants of the role",
+			err.Error(),
+		)
+		return
+	}
+	accessByKeyspace, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, scylladb.AccessByKeyspace(grants))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.AccessByKeyspace = accessByKeyspace
+
+	if !config.ResourceType.IsNull() {
+		permissions, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) ([]string, error) {
+			return c.GetRoleEffectivePermissions(curRole.Role, scylladb.Grant{
+				ResourceType: config.ResourceType.ValueString(),
+				Keyspace:     config.Keyspace.ValueString(),
+				Identifier:   config.Identifier.ValueString(),
+			})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read the effective permissions of the role",
+				err.Error(),
+			)
+			return
+		}
+		effectivePermissions, diags := types.ListValueFrom(ctx, types.StringType, permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.EffectivePermissions = effectivePermissions
+	}
+
 	// Set state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
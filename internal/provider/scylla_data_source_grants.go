@@ -0,0 +1,148 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &grantsDataSource{}
+	_ datasource.DataSourceWithConfigure = &grantsDataSource{}
+)
+
+// NewGrantsDataSource is a helper function to simplify the provider implementation.
+func NewGrantsDataSource() datasource.DataSource {
+	return &grantsDataSource{}
+}
+
+// grantsDataSource is the data source implementation.
+type grantsDataSource struct {
+	client *scylladb.Cluster
+}
+
+// grantsDataSourceModel maps the data source schema data.
+type grantsDataSourceModel struct {
+	RoleName  types.String                 `tfsdk:"role_name"`
+	Recursive types.Bool                   `tfsdk:"recursive"`
+	Grants    []grantsDataSourceGrantModel `tfsdk:"grants"`
+}
+
+// grantsDataSourceGrantModel maps a single permission in the grants list.
+type grantsDataSourceGrantModel struct {
+	Role       types.String `tfsdk:"role"`
+	Resource   types.String `tfsdk:"resource"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+// Metadata returns the data source type name.
+func (d *grantsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grants"
+}
+
+// Schema defines the schema for the data source.
+func (d *grantsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the permissions of a ScyllaDB role, as LIST ALL PERMISSIONS OF reports them.",
+		Attributes: map[string]schema.Attribute{
+			"role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The role to list the permissions of",
+			},
+			"recursive": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to include the permissions the role inherits from the roles it is a member of. " +
+					"When false, only the permissions granted to the role directly are listed. Defaults to true",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The permissions of the role, one per resource and permission, sorted by resource and permission",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role the permission is granted to: role_name, or a role it inherits the permission from",
+						},
+						"resource": schema.StringAttribute{
+							Computed:    true,
+							Description: "The resource as LIST formats it, e.g. <table cycling.cyclist_name>",
+						},
+						"permission": schema.StringAttribute{
+							Computed:    true,
+							Description: "The permission, e.g. SELECT",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *grantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config grantsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recursive := config.Recursive.IsNull() || config.Recursive.ValueBool()
+	permissions, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) ([]scylladb.Permission, error) {
+		return c.ListRolePermissions(config.RoleName.ValueString(), recursive)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list the permissions of the role",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model.
+	state := grantsDataSourceModel{
+		RoleName:  config.RoleName,
+		Recursive: config.Recursive,
+		Grants:    []grantsDataSourceGrantModel{},
+	}
+	for _, permission := range permissions {
+		state.Grants = append(state.Grants, grantsDataSourceGrantModel{
+			Role:       types.StringValue(permission.Role),
+			Resource:   types.StringValue(permission.Resource),
+			Permission: types.StringValue(permission.Permission),
+		})
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *grantsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
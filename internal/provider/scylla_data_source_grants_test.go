@@ -0,0 +1,66 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccGrantsDataSource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateRole(scylladb.Role{Role: "readers"}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	if err := cluster.CreateRole(scylladb.Role{Role: "app", MemberOf: []string{"readers"}}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	for _, grant := range []scylladb.Grant{
+		{RoleName: "readers", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{RoleName: "app", Privilege: "MODIFY", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"},
+	} {
+		if err := cluster.CreateGrant(grant); err != nil {
+			t.Fatalf("failed to create grant: %s", err)
+		}
+	}
+
+	config := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_grants" "app" {
+  role_name = "app"
+}
+
+data "scylladb_grants" "app_direct" {
+  role_name = "app"
+  recursive = false
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.#", "2"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.0.role", "readers"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.0.resource", "<keyspace cycling>"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.0.permission", "SELECT"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.1.role", "app"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app", "grants.1.resource", "<table cycling.cyclist_name>"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app_direct", "grants.#", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_grants.app_direct", "grants.0.permission", "MODIFY"),
+				),
+			},
+		},
+	})
+}
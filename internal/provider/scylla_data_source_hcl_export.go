@@ -0,0 +1,187 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &hclExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &hclExportDataSource{}
+)
+
+// NewHCLExportDataSource is a helper function to simplify the provider implementation.
+func NewHCLExportDataSource() datasource.DataSource {
+	return &hclExportDataSource{}
+}
+
+// hclExportDataSource is the data source implementation.
+type hclExportDataSource struct {
+	client *scylladb.Cluster
+}
+
+// hclExportDataSourceModel maps the data source schema data.
+type hclExportDataSourceModel struct {
+	HCL types.String `tfsdk:"hcl"`
+}
+
+// Metadata returns the data source type name.
+func (d *hclExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hcl_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *hclExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates `scylladb_role` and `scylladb_grant` resources with matching `import` blocks for every role of the cluster " +
+			"and its grants on keyspaces and tables, to bootstrap managing an existing cluster with Terraform. The output is informational only.",
+		Attributes: map[string]schema.Attribute{
+			"hcl": schema.StringAttribute{
+				Computed:    true,
+				Description: "The generated configuration",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *hclExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *hclExportDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	roles, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, (*scylladb.Cluster).ListRoles)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Roles", err.Error())
+		return
+	}
+	// Stream the grants of all roles at once rather than listing them role by role, so that
+	// clusters with many roles take a single paged query.
+	grants, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) (map[string][]scylladb.Grant, error) {
+		grants := make(map[string][]scylladb.Grant, len(roles))
+		err := c.StreamAllPermissions(func(p scylladb.Permission) error {
+			if grant, ok := p.Grant(); ok {
+				grants[grant.RoleName] = append(grants[grant.RoleName], grant)
+			}
+			return nil
+		})
+		return grants, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to List Grants", err.Error())
+		return
+	}
+	for _, roleGrants := range grants {
+		slices.SortFunc(roleGrants, scylladb.CompareGrants)
+	}
+
+	state := hclExportDataSourceModel{
+		HCL: types.StringValue(string(generateImportHCL(roles, grants))),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// generateImportHCL returns a resource and an import block for each role and for each of its
+// grants on keyspaces and tables. Grants on other resources have no matching resource type and
+// are left out.
+func generateImportHCL(roles []scylladb.Role, grants map[string][]scylladb.Grant) []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	used := make(map[string]bool)
+
+	for _, role := range roles {
+		roleLabel := uniqueLabel(used, role.Role)
+		appendImportBlock(body, "scylladb_role."+roleLabel, role.Role)
+		roleBlock := body.AppendNewBlock("resource", []string{"scylladb_role", roleLabel}).Body()
+		roleBlock.SetAttributeValue("role", cty.StringVal(role.Role))
+		roleBlock.SetAttributeValue("can_login", cty.BoolVal(role.CanLogin))
+		roleBlock.SetAttributeValue("is_superuser", cty.BoolVal(role.IsSuperuser))
+		body.AppendNewline()
+
+		for _, grant := range grants[role.Role] {
+			if !slices.Contains([]string{"ALL KEYSPACES", "KEYSPACE", "TABLE"}, strings.ToUpper(grant.ResourceType)) {
+				continue
+			}
+			grantLabel := uniqueLabel(used, strings.Join([]string{role.Role, grant.Privilege, grant.ResourceType, grant.Keyspace, grant.Identifier}, "_"))
+			appendImportBlock(body, "scylladb_grant."+grantLabel, grantID(grant))
+			grantBlock := body.AppendNewBlock("resource", []string{"scylladb_grant", grantLabel}).Body()
+			grantBlock.SetAttributeTraversal("role_name", hcl.Traversal{
+				hcl.TraverseRoot{Name: "scylladb_role"},
+				hcl.TraverseAttr{Name: roleLabel},
+				hcl.TraverseAttr{Name: "role"},
+			})
+			grantBlock.SetAttributeValue("privilege", cty.StringVal(grant.Privilege))
+			grantBlock.SetAttributeValue("resource_type", cty.StringVal(grant.ResourceType))
+			if grant.Keyspace != "" {
+				grantBlock.SetAttributeValue("keyspace", cty.StringVal(grant.Keyspace))
+			}
+			if grant.Identifier != "" {
+				grantBlock.SetAttributeValue("identifier", cty.StringVal(grant.Identifier))
+			}
+			body.AppendNewline()
+		}
+	}
+	return file.Bytes()
+}
+
+func appendImportBlock(body *hclwrite.Body, to, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	resourceType, name, _ := strings.Cut(to, ".")
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.SetAttributeValue("id", cty.StringVal(id))
+}
+
+// uniqueLabel turns name into a valid resource name that is not in used yet, and records it.
+// Characters that are not allowed in identifiers become underscores and a numeric suffix
+// disambiguates names that collide after that.
+func uniqueLabel(used map[string]bool, name string) string {
+	label := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return '_'
+		}
+	}, strings.TrimRight(name, "_"))
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+	candidate := label
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[candidate] = true
+	return candidate
+}
@@ -0,0 +1,90 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestGenerateImportHCL(t *testing.T) {
+	roles := []scylladb.Role{
+		{Role: "analyst"},
+		{Role: "App.User", CanLogin: true},
+		{Role: "app_user"},
+	}
+	grants := map[string][]scylladb.Grant{
+		"analyst": {
+			{RoleName: "analyst", Privilege: "SELECT", ResourceType: "ALL KEYSPACES"},
+			{RoleName: "analyst", Privilege: "MODIFY", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"},
+			{RoleName: "analyst", Privilege: "ALTER", ResourceType: "ROLE", Keyspace: "app_user"},
+		},
+	}
+
+	generated := generateImportHCL(roles, grants)
+	file, diags := hclsyntax.ParseConfig(generated, "export.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags, generated)
+	}
+
+	var resources, imports []string
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		switch block.Type {
+		case "resource":
+			resources = append(resources, block.Labels[0]+"."+block.Labels[1])
+		case "import":
+			imports = append(imports, string(block.Body.Attributes["to"].Expr.Range().SliceBytes(generated)))
+		}
+	}
+	want := []string{
+		"scylladb_role.analyst",
+		"scylladb_grant.analyst_select_all_keyspaces",
+		"scylladb_grant.analyst_modify_table_cycling_cyclist_name",
+		"scylladb_role.app_user",
+		"scylladb_role.app_user_2",
+	}
+	if fmt.Sprint(resources) != fmt.Sprint(want) {
+		t.Errorf("expected resources %v, got %v", want, resources)
+	}
+	if fmt.Sprint(imports) != fmt.Sprint(want) {
+		t.Errorf("expected imports %v, got %v", want, imports)
+	}
+
+	for _, expected := range []string{
+		`role_name     = scylladb_role.analyst.role`,
+		`id = "analyst|MODIFY|TABLE|cycling|cyclist_name"`,
+		`role         = "App.User"`,
+		`can_login    = true`,
+	} {
+		if !regexp.MustCompile(regexp.QuoteMeta(expected)).Match(generated) {
+			t.Errorf("expected the generated HCL to contain %q:\n%s", expected, generated)
+		}
+	}
+}
+
+func TestAccHCLExportDataSource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "scylladb_hcl_export" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.scylladb_hcl_export.all", "hcl",
+						regexp.MustCompile(`resource "scylladb_role" "cassandra" \{\n  role         = "cassandra"`)),
+					resource.TestMatchResourceAttr("data.scylladb_hcl_export.all", "hcl",
+						regexp.MustCompile(`to = scylladb_role.cassandra\n  id = "cassandra"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &keyspaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &keyspaceDataSource{}
+)
+
+// NewKeyspaceDataSource is a helper function to simplify the provider implementation.
+func NewKeyspaceDataSource() datasource.DataSource {
+	return &keyspaceDataSource{}
+}
+
+// keyspaceDataSource is the data source implementation.
+type keyspaceDataSource struct {
+	client *scylladb.Cluster
+}
+
+// keyspaceDataSourceModel maps the data source schema data.
+type keyspaceDataSourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	ReplicationClass     types.String `tfsdk:"replication_class"`
+	ReplicationFactor    types.Int64  `tfsdk:"replication_factor"`
+	Datacenters          types.Map    `tfsdk:"datacenters"`
+	DurableWrites        types.Bool   `tfsdk:"durable_writes"`
+	EffectiveReplication types.Map    `tfsdk:"effective_replication"`
+}
+
+// Metadata returns the data source type name.
+func (d *keyspaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keyspace"
+}
+
+// Schema defines the schema for the data source.
+func (d *keyspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing keyspace and the replication it achieves on the current nodes.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the keyspace to look up.",
+			},
+			"replication_class": schema.StringAttribute{
+				Computed:    true,
+				Description: "The replication strategy of the keyspace",
+			},
+			"replication_factor": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The configured replication factor, for SimpleStrategy keyspaces",
+			},
+			"datacenters": schema.MapAttribute{
+				Computed:    true,
+				Description: "The configured replication factor of each datacenter, for NetworkTopologyStrategy keyspaces",
+				ElementType: types.Int64Type,
+			},
+			"durable_writes": schema.BoolAttribute{
+				Computed:    true,
+				Description: "whether writes to the keyspace go through the commit log",
+			},
+			"effective_replication": schema.MapAttribute{
+				Computed: true,
+				Description: "The number of replicas each datacenter actually holds, which is lower than configured when the datacenter has fewer nodes. " +
+					"SimpleStrategy keyspaces are reported under the single key all",
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *keyspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config keyspaceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ks, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) (scylladb.Keyspace, error) {
+		return c.GetKeyspace(config.Name.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the keyspace",
+			err.Error(),
+		)
+		return
+	}
+	nodeCounts, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, (*scylladb.Cluster).GetDatacenterNodeCounts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the nodes of the cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	effective, shortfalls := scylladb.EffectiveReplication(ks, nodeCounts)
+	for _, shortfall := range shortfalls {
+		resp.Diagnostics.AddAttributeWarning(path.Root("effective_replication"), "Replication Factor Exceeds Available Nodes",
+			fmt.Sprintf("The keyspace %s requests %d replicas in %s, which only has %d nodes, so it only holds %d replicas there. "+
+				"Add nodes or lower the replication factor.",
+				ks.Name, shortfall.Requested, shortfall.Datacenter, shortfall.Available, shortfall.Available))
+	}
+
+	// Map response body to model.
+	state := keyspaceDataSourceModel{
+		Name:              config.Name,
+		ReplicationClass:  types.StringValue(ks.ReplicationClass),
+		ReplicationFactor: types.Int64Null(),
+		Datacenters:       types.MapNull(types.Int64Type),
+		DurableWrites:     types.BoolValue(ks.DurableWrites),
+	}
+	if ks.ReplicationClass == scylladb.NetworkTopologyStrategy {
+		datacenters, diags := types.MapValueFrom(ctx, types.Int64Type, ks.DatacenterReplication)
+		resp.Diagnostics.Append(diags...)
+		state.Datacenters = datacenters
+	} else {
+		state.ReplicationFactor = types.Int64Value(int64(ks.ReplicationFactor))
+	}
+	effectiveReplication, diags := types.MapValueFrom(ctx, types.Int64Type, effective)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.EffectiveReplication = effectiveReplication
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *keyspaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
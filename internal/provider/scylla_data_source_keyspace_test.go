@@ -0,0 +1,51 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccKeyspaceDataSourceEffectiveReplication(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+
+	// The test cluster has a single node, so only one of the three replicas can exist
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateKeyspace(scylladb.Keyspace{
+		Name:              "overreplicated",
+		ReplicationClass:  "SimpleStrategy",
+		ReplicationFactor: 3,
+		DurableWrites:     true,
+	}); err != nil {
+		t.Fatalf("failed to create keyspace: %s", err)
+	}
+
+	config := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_keyspace" "overreplicated" {
+  name = "overreplicated"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scylladb_keyspace.overreplicated", "replication_class", "SimpleStrategy"),
+					resource.TestCheckResourceAttr("data.scylladb_keyspace.overreplicated", "replication_factor", "3"),
+					resource.TestCheckResourceAttr("data.scylladb_keyspace.overreplicated", "effective_replication.%", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_keyspace.overreplicated", "effective_replication.all", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &keyspacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &keyspacesDataSource{}
+)
+
+// NewKeyspacesDataSource is a helper function to simplify the provider implementation.
+func NewKeyspacesDataSource() datasource.DataSource {
+	return &keyspacesDataSource{}
+}
+
+// keyspacesDataSource is the data source implementation.
+type keyspacesDataSource struct {
+	client *scylladb.Cluster
+}
+
+// keyspacesDataSourceModel maps the data source schema data.
+type keyspacesDataSourceModel struct {
+	IncludeSystem types.Bool                         `tfsdk:"include_system"`
+	Keyspaces     []keyspacesDataSourceKeyspaceModel `tfsdk:"keyspaces"`
+}
+
+// keyspacesDataSourceKeyspaceModel maps a single keyspace in the keyspaces list.
+type keyspacesDataSourceKeyspaceModel struct {
+	Name              types.String `tfsdk:"name"`
+	ReplicationClass  types.String `tfsdk:"replication_class"`
+	ReplicationFactor types.Int64  `tfsdk:"replication_factor"`
+	Datacenters       types.Map    `tfsdk:"datacenters"`
+	DurableWrites     types.Bool   `tfsdk:"durable_writes"`
+}
+
+// Metadata returns the data source type name.
+func (d *keyspacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keyspaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *keyspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all keyspaces.",
+		Attributes: map[string]schema.Attribute{
+			"include_system": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include the keyspaces ScyllaDB uses internally, such as system and system_schema. Default is false",
+			},
+			"keyspaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All keyspaces, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the keyspace",
+						},
+						"replication_class": schema.StringAttribute{
+							Computed:    true,
+							Description: "The replication strategy of the keyspace",
+						},
+						"replication_factor": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The replication factor, for SimpleStrategy keyspaces",
+						},
+						"datacenters": schema.MapAttribute{
+							Computed:    true,
+							Description: "The replication factor of each datacenter, for NetworkTopologyStrategy keyspaces",
+							ElementType: types.Int64Type,
+						},
+						"durable_writes": schema.BoolAttribute{
+							Computed:    true,
+							Description: "whether writes to the keyspace go through the commit log",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *keyspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config keyspacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyspaces, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, func(c *scylladb.Cluster) ([]scylladb.Keyspace, error) {
+		return c.ListKeyspaces(config.IncludeSystem.ValueBool())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list the keyspaces",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model.
+	state := keyspacesDataSourceModel{
+		IncludeSystem: config.IncludeSystem,
+		Keyspaces:     []keyspacesDataSourceKeyspaceModel{},
+	}
+	for _, ks := range keyspaces {
+		keyspaceState := keyspacesDataSourceKeyspaceModel{
+			Name:              types.StringValue(ks.Name),
+			ReplicationClass:  types.StringValue(ks.ReplicationClass),
+			ReplicationFactor: types.Int64Null(),
+			Datacenters:       types.MapNull(types.Int64Type),
+			DurableWrites:     types.BoolValue(ks.DurableWrites),
+		}
+		if ks.ReplicationClass == scylladb.NetworkTopologyStrategy {
+			datacenters, diags := types.MapValueFrom(ctx, types.Int64Type, ks.DatacenterReplication)
+			resp.Diagnostics.Append(diags...)
+			keyspaceState.Datacenters = datacenters
+		} else {
+			keyspaceState.ReplicationFactor = types.Int64Value(int64(ks.ReplicationFactor))
+		}
+		state.Keyspaces = append(state.Keyspaces, keyspaceState)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *keyspacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,41 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+)
+
+func TestAccKeyspacesDataSource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	config := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_keyspaces" "user" {}
+
+data "scylladb_keyspaces" "all" {
+  include_system = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scylladb_keyspaces.user", "keyspaces.#", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_keyspaces.user", "keyspaces.0.name", "cycling"),
+					resource.TestCheckResourceAttrSet("data.scylladb_keyspaces.user", "keyspaces.0.replication_class"),
+					resource.TestCheckResourceAttrSet("data.scylladb_keyspaces.user", "keyspaces.0.durable_writes"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.scylladb_keyspaces.all", "keyspaces.*", map[string]string{
+						"name": "system_schema",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &privilegeExpansionDataSource{}
+
+// NewPrivilegeExpansionDataSource is a helper function to simplify the provider implementation.
+func NewPrivilegeExpansionDataSource() datasource.DataSource {
+	return &privilegeExpansionDataSource{}
+}
+
+// privilegeExpansionDataSource is the data source implementation. It does not query the cluster.
+type privilegeExpansionDataSource struct{}
+
+// privilegeExpansionDataSourceModel maps the data source schema data.
+type privilegeExpansionDataSourceModel struct {
+	Privilege    types.String `tfsdk:"privilege"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Privileges   types.List   `tfsdk:"privileges"`
+}
+
+// Metadata returns the data source type name.
+func (d *privilegeExpansionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege_expansion"
+}
+
+// Schema defines the schema for the data source.
+func (d *privilegeExpansionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Expands a privilege keyword into the individual privileges it grants on a resource type.",
+		Attributes: map[string]schema.Attribute{
+			"privilege": schema.StringAttribute{
+				Description: "The privilege to expand (e.g., ALL PERMISSIONS, SELECT).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(
+						"ALL PERMISSIONS",
+						"ALTER",
+						"AUTHORIZE",
+						"CREATE",
+						"DESCRIBE",
+						"DROP",
+						"EXECUTE",
+						"MODIFY",
+						"SELECT",
+					),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The type of resource (e.g., ALL KEYSPACES, KEYSPACE, TABLE, ALL ROLES, ROLE, ALL FUNCTIONS, FUNCTION).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(
+						"ALL KEYSPACES",
+						"KEYSPACE",
+						"TABLE",
+						"ALL ROLES",
+						"ROLE",
+						"ALL FUNCTIONS",
+						"ALL FUNCTIONS IN KEYSPACE",
+						"FUNCTION",
+					),
+				},
+			},
+			"privileges": schema.ListAttribute{
+				Computed:    true,
+				Description: "The individual privileges, in upper case and sorted",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read expands the privilege with the same logic the grant resource uses to record permissions.
+func (d *privilegeExpansionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state privilegeExpansionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant := scylladb.Grant{
+		Privilege:    state.Privilege.ValueString(),
+		ResourceType: state.ResourceType.ValueString(),
+	}
+	privileges, diags := types.ListValueFrom(ctx, types.StringType, grant.GetExpandedPermissions())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Privileges = privileges
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
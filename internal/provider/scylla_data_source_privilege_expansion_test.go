@@ -0,0 +1,50 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+)
+
+func TestAccPrivilegeExpansionDataSource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "scylladb_privilege_expansion" "keyspace" {
+  privilege     = "ALL PERMISSIONS"
+  resource_type = "KEYSPACE"
+}
+data "scylladb_privilege_expansion" "table" {
+  privilege     = "all permissions"
+  resource_type = "TABLE"
+}
+data "scylladb_privilege_expansion" "role" {
+  privilege     = "ALL PERMISSIONS"
+  resource_type = "ROLE"
+}
+data "scylladb_privilege_expansion" "select" {
+  privilege     = "select"
+  resource_type = "TABLE"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.keyspace", "privileges.#", "6"),
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.keyspace", "privileges.2", "CREATE"),
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.table", "privileges.#", "5"),
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.role", "privileges.#", "3"),
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.select", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_privilege_expansion.select", "privileges.0", "SELECT"),
+				),
+			},
+		},
+	})
+}
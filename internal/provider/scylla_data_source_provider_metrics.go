@@ -0,0 +1,101 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providerMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &providerMetricsDataSource{}
+)
+
+// NewProviderMetricsDataSource is a helper function to simplify the provider implementation.
+func NewProviderMetricsDataSource() datasource.DataSource {
+	return &providerMetricsDataSource{}
+}
+
+// providerMetricsDataSource is the data source implementation.
+type providerMetricsDataSource struct {
+	client *scylladb.Cluster
+}
+
+// providerMetricsDataSourceModel maps the data source schema data.
+type providerMetricsDataSourceModel struct {
+	Queries        types.Int64  `tfsdk:"queries"`
+	Errors         types.Int64  `tfsdk:"errors"`
+	AverageLatency types.String `tfsdk:"average_latency"`
+}
+
+// Metadata returns the data source type name.
+func (d *providerMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *providerMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the queries the provider ran so far in the current Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"queries": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of query attempts. A query that was retried counts once per attempt",
+			},
+			"errors": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of query attempts that failed",
+			},
+			"average_latency": schema.StringAttribute{
+				Computed:    true,
+				Description: "The mean duration of a query attempt, as a Go duration string",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providerMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	metrics := d.client.Metrics()
+
+	// Map response body to model.
+	state := providerMetricsDataSourceModel{
+		Queries:        types.Int64Value(metrics.Queries),
+		Errors:         types.Int64Value(metrics.Errors),
+		AverageLatency: types.StringValue(metrics.AverageLatency.String()),
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providerMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,49 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+)
+
+func TestAccProviderMetricsDataSource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+
+	config := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_role" "cassandra" {
+  id = "cassandra"
+}
+
+data "scylladb_provider_metrics" "run" {
+  depends_on = [data.scylladb_role.cassandra]
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Reading the role and its members takes at least two queries
+					resource.TestCheckResourceAttrWith("data.scylladb_provider_metrics.run", "queries", func(value string) error {
+						queries, err := strconv.Atoi(value)
+						if err != nil {
+							return err
+						}
+						if queries < 2 {
+							return fmt.Errorf("expected at least 2 queries, got %d", queries)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("data.scylladb_provider_metrics.run", "errors"),
+					resource.TestCheckResourceAttrSet("data.scylladb_provider_metrics.run", "average_latency"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	client *scylladb.Cluster
+}
+
+// rolesDataSourceModel maps the data source schema data.
+type rolesDataSourceModel struct {
+	CanLoginOnly types.Bool                 `tfsdk:"can_login_only"`
+	Roles        []rolesDataSourceRoleModel `tfsdk:"roles"`
+}
+
+// rolesDataSourceRoleModel maps a single role in the roles list.
+type rolesDataSourceRoleModel struct {
+	Role        types.String   `tfsdk:"role"`
+	CanLogin    types.Bool     `tfsdk:"can_login"`
+	IsSuperuser types.Bool     `tfsdk:"is_superuser"`
+	MemberOf    []types.String `tfsdk:"member_of"`
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all ScyllaDB roles.",
+		Attributes: map[string]schema.Attribute{
+			"can_login_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list the roles that can log in, such as user and service accounts, leaving out roles that only group permissions",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All roles, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the role",
+						},
+						"can_login": schema.BoolAttribute{
+							Computed:    true,
+							Description: "whether a user can login as a role",
+						},
+						"is_superuser": schema.BoolAttribute{
+							Computed:    true,
+							Description: "whether the role is a superuser",
+						},
+						"member_of": schema.ListAttribute{
+							Computed:    true,
+							Description: "a list of members of the role",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config rolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := readWithFallback(d.client.WithContext(ctx), &resp.Diagnostics, (*scylladb.Cluster).ListRoles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to list the roles",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model.
+	state := rolesDataSourceModel{
+		CanLoginOnly: config.CanLoginOnly,
+		Roles:        []rolesDataSourceRoleModel{},
+	}
+	for _, role := range roles {
+		if config.CanLoginOnly.ValueBool() && !role.CanLogin {
+			continue
+		}
+		roleState := rolesDataSourceRoleModel{
+			Role:        types.StringValue(role.Role),
+			CanLogin:    types.BoolValue(role.CanLogin),
+			IsSuperuser: types.BoolValue(role.IsSuperuser),
+		}
+		for _, member := range role.MemberOf {
+			roleState.MemberOf = append(roleState.MemberOf, types.StringValue(member))
+		}
+		state.Roles = append(state.Roles, roleState)
+	}
+
+	// Set state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
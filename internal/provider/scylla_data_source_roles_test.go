@@ -0,0 +1,85 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccRolesDataSourceBulkImport(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+
+	// Seed roles outside of Terraform
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	for _, role := range []scylladb.Role{
+		{Role: "app", CanLogin: true},
+		{Role: "readers"},
+	} {
+		if err := cluster.CreateRole(role); err != nil {
+			t.Fatalf("failed to create role %s: %s", role.Role, err)
+		}
+	}
+
+	config := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_roles" "all" {}
+
+data "scylladb_roles" "logins" {
+  can_login_only = true
+}
+
+locals {
+  roles = { for r in data.scylladb_roles.all.roles : r.role => r if r.role != "cassandra" }
+}
+
+import {
+  for_each = local.roles
+  to       = scylladb_role.imported[each.key]
+  id       = each.key
+}
+
+resource "scylladb_role" "imported" {
+  for_each     = local.roles
+  role         = each.key
+  can_login    = each.value.can_login
+  is_superuser = each.value.is_superuser
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import all non-default roles
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scylladb_roles.all", "roles.#", "3"),
+					resource.TestCheckResourceAttr("data.scylladb_roles.all", "roles.0.role", "app"),
+					resource.TestCheckResourceAttr("data.scylladb_roles.logins", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.scylladb_roles.logins", "roles.0.role", "app"),
+					resource.TestCheckResourceAttr("data.scylladb_roles.logins", "roles.1.role", "cassandra"),
+					resource.TestCheckResourceAttr("scylladb_role.imported[\"app\"]", "can_login", "true"),
+					resource.TestCheckResourceAttr("scylladb_role.imported[\"readers\"]", "can_login", "false"),
+				),
+			},
+			// Imported roles are fully populated, so the next plan is empty
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
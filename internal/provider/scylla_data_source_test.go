@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
 
 func TestAccRoleDataSource(t *testing.T) {
@@ -28,6 +29,66 @@ data "scylladb_role" "cassandra" {
 					resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "role", "cassandra"),
 					resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "can_login", "true"),
 					resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "is_superuser", "true"),
+					resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "has_password", "true"),
+					resource.TestCheckResourceAttr("data.scylladb_role.cassandra", "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleDataSourceEffectivePermissions(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	for _, name := range []string{"readers", "analyst"} {
+		if err := cluster.CreateRole(scylladb.Role{Role: name}); err != nil {
+			t.Fatalf("failed to create role: %s", err)
+		}
+	}
+	if err := cluster.Session.Query(`GRANT readers TO analyst`).Exec(); err != nil {
+		t.Fatalf("failed to grant role membership: %s", err)
+	}
+	if err := cluster.CreateGrant(scylladb.Grant{
+		RoleName:     "readers",
+		Privilege:    "SELECT",
+		ResourceType: "KEYSPACE",
+		Keyspace:     "cycling",
+	}); err != nil {
+		t.Fatalf("failed to create grant: %s", err)
+	}
+
+	dataConfig := fmt.Sprintf(providerConfigFmt, devClusterHost) + `
+data "scylladb_role" "analyst" {
+  id            = "analyst"
+  resource_type = "TABLE"
+  keyspace      = "cycling"
+  identifier    = "cyclist_name"
+}
+data "scylladb_role" "readers" {
+  id = "readers"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: dataConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// SELECT is inherited from readers, which holds it on the keyspace
+					resource.TestCheckResourceAttr("data.scylladb_role.analyst", "effective_permissions.#", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_role.analyst", "effective_permissions.0", "SELECT"),
+					// Without a resource, no effective permissions are read
+					resource.TestCheckNoResourceAttr("data.scylladb_role.readers", "effective_permissions"),
+					// Only direct grants are summarized
+					resource.TestCheckResourceAttr("data.scylladb_role.readers", "access_by_keyspace.cycling.#", "1"),
+					resource.TestCheckResourceAttr("data.scylladb_role.readers", "access_by_keyspace.cycling.0", "SELECT"),
+					resource.TestCheckResourceAttr("data.scylladb_role.analyst", "access_by_keyspace.%", "0"),
 				),
 			},
 		},
@@ -0,0 +1,128 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+// defaultClientCertificateValidity is used when validity is not set.
+const defaultClientCertificateValidity = time.Hour
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ ephemeral.EphemeralResource = &clientCertificateEphemeralResource{}
+
+// NewClientCertificateEphemeralResource is a helper function to simplify the provider implementation.
+func NewClientCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &clientCertificateEphemeralResource{}
+}
+
+// clientCertificateEphemeralResource is the ephemeral resource implementation.
+type clientCertificateEphemeralResource struct{}
+
+// clientCertificateEphemeralResourceModel maps the ephemeral resource schema data.
+type clientCertificateEphemeralResourceModel struct {
+	CACert     types.String `tfsdk:"ca_cert"`
+	CAKey      types.String `tfsdk:"ca_key"`
+	CommonName types.String `tfsdk:"common_name"`
+	Validity   types.String `tfsdk:"validity"`
+	CertPEM    types.String `tfsdk:"cert_pem"`
+	KeyPEM     types.String `tfsdk:"key_pem"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *clientCertificateEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_certificate"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *clientCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a short-lived mTLS client certificate signed by the given CA. The certificate is never stored in state and expires on its own.",
+		Attributes: map[string]schema.Attribute{
+			"ca_cert": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate used to sign the client certificate.",
+				Required:    true,
+			},
+			"ca_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of the CA. PKCS#1, PKCS#8, and EC keys are supported.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"common_name": schema.StringAttribute{
+				Description: "Common name of the client certificate. With certificate authentication this is the role the client logs in as.",
+				Required:    true,
+			},
+			"validity": schema.StringAttribute{
+				Description: "How long the certificate is valid for, as a Go duration string (e.g. `30m`). Defaults to `1h`.",
+				Optional:    true,
+			},
+			"cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded client certificate.",
+				Computed:    true,
+			},
+			"key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key of the client certificate.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry time of the client certificate in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open issues a new client certificate.
+func (r *clientCertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data clientCertificateEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validity := defaultClientCertificateValidity
+	if !data.Validity.IsNull() {
+		var err error
+		validity, err = time.ParseDuration(data.Validity.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validity"),
+				"Invalid Certificate Validity",
+				fmt.Sprintf("The validity %q is not a valid duration: %s", data.Validity.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	cert, err := scylladb.GenerateClientCertificate(
+		[]byte(data.CACert.ValueString()),
+		[]byte(data.CAKey.ValueString()),
+		data.CommonName.ValueString(),
+		validity,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Issue Client Certificate",
+			"An unexpected error occurred when issuing the client certificate.\n\n"+err.Error(),
+		)
+		return
+	}
+
+	data.CertPEM = types.StringValue(string(cert.CertPEM))
+	data.KeyPEM = types.StringValue(string(cert.KeyPEM))
+	data.ExpiresAt = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,6 +25,10 @@ var _ resource.Resource = &grantResource{}
 var _ resource.ResourceWithConfigure = &grantResource{}
 var _ resource.ResourceWithImportState = &grantResource{}
 var _ resource.ResourceWithModifyPlan = &grantResource{}
+var _ resource.ResourceWithValidateConfig = &grantResource{}
+
+// grantTargetWait bounds how long Create waits for the keyspace or table of a grant to exist.
+const grantTargetWait = 10 * time.Second
 
 func NewGrantResource() resource.Resource {
 	return &grantResource{}
@@ -32,13 +39,19 @@ type grantResource struct {
 }
 
 type grantResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	RoleName     types.String `tfsdk:"role_name"`
-	Privilege    types.String `tfsdk:"privilege"`
-	ResourceType types.String `tfsdk:"resource_type"`
-	Keyspace     types.String `tfsdk:"keyspace"`
-	Identifier   types.String `tfsdk:"identifier"`
-	Permissions  types.List   `tfsdk:"permissions"`
+	ID                        types.String          `tfsdk:"id"`
+	ImportID                  types.String          `tfsdk:"import_id"`
+	RoleName                  types.String          `tfsdk:"role_name"`
+	Privilege                 caseInsensitiveString `tfsdk:"privilege"`
+	ResourceType              caseInsensitiveString `tfsdk:"resource_type"`
+	Keyspace                  types.String          `tfsdk:"keyspace"`
+	Identifier                types.String          `tfsdk:"identifier"`
+	Permissions               types.List            `tfsdk:"permissions"`
+	AdoptExisting             types.Bool            `tfsdk:"adopt_existing"`
+	AllowSystemKeyspaceGrants types.Bool            `tfsdk:"allow_system_keyspace_grants"`
+	CrossCheckPermissions     types.Bool            `tfsdk:"cross_check_permissions"`
+	RevokeAllOnDelete         types.Bool            `tfsdk:"revoke_all_on_delete"`
+	SystemAuthKeyspace        types.String          `tfsdk:"system_auth_keyspace"`
 }
 
 func (g *grantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,13 +66,19 @@ func (g *grantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The ID of the grant.",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				MarkdownDescription: "The ID to import the grant with, in the format `RoleName|Privilege|ResourceType|Keyspace|Identifier`. " +
+					"It can be copied into an `import` block or a `terraform import` command as is.",
+				Computed: true,
+			},
 			"role_name": schema.StringAttribute{
 				Description: "The role to which the privilege is granted.",
 				Required:    true,
 			},
 			"privilege": schema.StringAttribute{
-				Description: "The privilege to grant.",
+				Description: "The privilege to grant. It is case-insensitive and stored in uppercase.",
 				Required:    true,
+				CustomType:  caseInsensitiveStringType{},
 				Validators: []validator.String{
 					stringvalidator.OneOfCaseInsensitive(
 						// Refer to https://docs.scylladb.com/manual/stable/operating-scylla/security/authorization.html#grant-permission
@@ -69,22 +88,29 @@ func (g *grantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"CREATE",
 						"DESCRIBE",
 						"DROP",
+						"EXECUTE",
 						"MODIFY",
 						"SELECT",
 					),
 				},
 			},
 			"resource_type": schema.StringAttribute{
-				Description: "The type of resource (e.g., ALL KEYSPACES, KEYSPACE, TABLE).",
+				Description: "The type of resource (e.g., ALL KEYSPACES, KEYSPACE, TABLE, ALL ROLES, ALL FUNCTIONS, FUNCTION). It is case-insensitive and stored in uppercase.",
 				Required:    true,
+				CustomType:  caseInsensitiveStringType{},
 				Validators: []validator.String{
 					stringvalidator.OneOfCaseInsensitive(
 						// Refer to https://docs.scylladb.com/manual/stable/operating-scylla/security/authorization.html#grant-permission
 						"ALL KEYSPACES",
 						"KEYSPACE",
 						"TABLE",
+						"ALL ROLES",
+						"ALL FUNCTIONS",
+						"ALL FUNCTIONS IN KEYSPACE",
+						"FUNCTION",
 						// "ALL USERS",
 						// "USER",
+						// "ALL MBEANS" and "MBEAN" are Cassandra JMX resources, which ScyllaDB does not authorize.
 					),
 				},
 			},
@@ -93,7 +119,7 @@ func (g *grantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"identifier": schema.StringAttribute{
-				Description: "The identifier of the resource (e.g., table name).",
+				Description: "The identifier of the resource (e.g., table name, or a function signature such as avg_state(int, int)).",
 				Optional:    true,
 			},
 			"permissions": schema.ListAttribute{
@@ -101,10 +127,93 @@ func (g *grantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The recorded permission for the grant",
 				ElementType: types.StringType,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt the grant into state when the role already holds it, instead of failing. " +
+					"An adopted grant is revoked when the resource is destroyed, like any other grant. Default is `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"allow_system_keyspace_grants": schema.BoolAttribute{
+				MarkdownDescription: "Allow the grant when `keyspace` is a ScyllaDB system keyspace such as `system` or `system_schema`. " +
+					"Granting on system keyspaces can expose internal tables, so it is refused unless this is set. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"cross_check_permissions": schema.BoolAttribute{
+				MarkdownDescription: "Compare the permissions recorded in `role_permissions` with the output of `LIST ALL PERMISSIONS` on every refresh, " +
+					"and warn when they differ. A difference points at permissions inherited from parent roles or enclosing resources, " +
+					"or at the permissions cache, which can explain why a grant behaves unexpectedly. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"revoke_all_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Revoke `privilege` as a whole when the resource is destroyed, e.g. `REVOKE ALL PERMISSIONS`. " +
+					"By default only the permissions recorded in `permissions` that `privilege` covers are revoked, one by one, " +
+					"so permissions the role gained on the resource through other grants are left in place. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"system_auth_keyspace": schema.StringAttribute{
+				MarkdownDescription: "The keyspace the permissions of the grant are read from, overriding the `system_auth_keyspace` of the provider. " +
+					"Only needed when roles live in different auth keyspaces.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig refuses privileges that do not apply to the resource type, keyspaces or
+// identifiers on ALL ROLES and ALL FUNCTIONS, malformed function signatures, and grants on system
+// keyspaces unless allow_system_keyspace_grants is set.
+func (g *grantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config grantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Privilege.IsUnknown() && !config.ResourceType.IsUnknown() {
+		grant := scylladb.Grant{Privilege: config.Privilege.ValueString(), ResourceType: config.ResourceType.ValueString()}
+		if err := grant.ValidatePrivilege(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("privilege"), "Privilege Not Applicable", err.Error())
+		}
+		if strings.EqualFold(grant.ResourceType, "ALL ROLES") || strings.EqualFold(grant.ResourceType, "ALL FUNCTIONS") {
+			for _, attr := range []struct {
+				name  string
+				value types.String
+			}{{"keyspace", config.Keyspace}, {"identifier", config.Identifier}} {
+				if !attr.value.IsNull() {
+					resp.Diagnostics.AddAttributeError(path.Root(attr.name), "Invalid Attribute Combination",
+						fmt.Sprintf("%s cannot be set on a grant on %s.", attr.name, strings.ToUpper(grant.ResourceType)))
+				}
+			}
+		}
+		if strings.EqualFold(grant.ResourceType, "FUNCTION") && !config.Identifier.IsUnknown() {
+			if err := scylladb.ValidateFunctionSignature(config.Identifier.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("identifier"), "Invalid Function Signature", err.Error())
+			}
+		}
+	}
+	if config.Keyspace.IsUnknown() || config.AllowSystemKeyspaceGrants.IsUnknown() {
+		return
+	}
+	if !scylladb.IsSystemKeyspace(config.Keyspace.ValueString()) || config.AllowSystemKeyspaceGrants.ValueBool() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("keyspace"),
+		"System Keyspace Grant Not Allowed",
+		fmt.Sprintf("The keyspace %q is a ScyllaDB system keyspace. Granting on it can expose internal tables. "+
+			"Set allow_system_keyspace_grants = true if the grant is intended.", config.Keyspace.ValueString()),
+	)
+}
+
 func (g *grantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -130,6 +239,7 @@ func (g *grantResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.normalizeKeywords()
 
 	grant := scylladb.Grant{
 		RoleName:     plan.RoleName.ValueString(),
@@ -138,22 +248,56 @@ func (g *grantResource) Create(ctx context.Context, req resource.CreateRequest,
 		Keyspace:     plan.Keyspace.ValueString(),
 		Identifier:   plan.Identifier.ValueString(),
 	}
-	err := g.client.CreateGrant(grant)
+
+	// GRANT is idempotent, so an existing grant would be silently taken over. Check first so it
+	// is either adopted explicitly or reported.
+	client := g.clusterFor(ctx, plan)
+	lookup, err := client.LookupGrant(grant)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Creating Grant",
+			"Error Reading Grant",
 			err.Error(),
 		)
 		return
 	}
-
-	permissions, err := g.client.GetGrantPermissions(grant)
-	if err != nil {
+	// An adopted grant keeps the permissions just read, saving a round trip; a new one is read
+	// again once it has been granted.
+	permissions := lookup.Permissions
+	switch {
+	case lookup.Exists && !plan.AdoptExisting.ValueBool():
 		resp.Diagnostics.AddError(
-			"Error Getting Grant Permissions",
-			err.Error(),
+			"Grant Already Exists",
+			fmt.Sprintf("The role %q already holds the %s privilege on the resource. "+
+				"Import the grant or set adopt_existing = true to manage it with Terraform.", grant.RoleName, grant.Privilege),
 		)
 		return
+	case lookup.Exists:
+		tflog.Info(ctx, "Adopting existing grant", map[string]any{"role": grant.RoleName, "privilege": grant.Privilege})
+	default:
+		// The target may be created in the same apply without an explicit reference, so give it
+		// a moment to appear before granting on it.
+		if err := client.WaitForGrantTarget(grant, grantTargetWait); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Grant",
+				err.Error(),
+			)
+			return
+		}
+		if err := client.CreateGrant(grant); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Grant",
+				err.Error(),
+			)
+			return
+		}
+		permissions, err = readNewGrantPermissions(client, grant, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Grant Permissions",
+				err.Error(),
+			)
+			return
+		}
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Adding permissions: %v", permissions))
 	permissionsList, diags := types.ListValueFrom(ctx, types.StringType, permissions)
@@ -163,13 +307,43 @@ func (g *grantResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	plan.Permissions = permissionsList
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s|%s|%s|%s|%s", grant.RoleName, grant.Privilege, grant.ResourceType, grant.Keyspace, grant.Identifier))
+	plan.ID = types.StringValue(grantID(grant))
+	plan.ImportID = plan.ID
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (g *grantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Refreshing the state based on the current state of db should not occur. Any change in the db warrants
 	// a replace. Refer to ModifyPlan for reading and resolving the diff.
+	var state grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.CrossCheckPermissions.ValueBool() {
+		return
+	}
+
+	grant := scylladb.Grant{
+		RoleName:     state.RoleName.ValueString(),
+		Privilege:    state.Privilege.ValueString(),
+		ResourceType: state.ResourceType.ValueString(),
+		Keyspace:     state.Keyspace.ValueString(),
+		Identifier:   state.Identifier.ValueString(),
+	}
+	stored, listed, err := g.clusterFor(ctx, state).CrossCheckGrantPermissions(grant)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Grant",
+			err.Error(),
+		)
+		return
+	}
+	if !slices.Equal(stored, listed) {
+		resp.Diagnostics.AddWarning(
+			"Grant Permissions Differ Between Sources",
+			fmt.Sprintf("The role %q holds %v on the resource according to role_permissions, but LIST ALL PERMISSIONS reports %v. "+
+				"This is expected when the role inherits permissions from parent roles or enclosing resources, "+
+				"and can otherwise indicate that the permissions cache has not caught up yet.", grant.RoleName, stored, listed),
+		)
+	}
 }
 
 func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -179,6 +353,7 @@ func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.normalizeKeywords()
 
 	// Get the role from state
 	fromGrant := scylladb.Grant{
@@ -201,6 +376,7 @@ func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.normalizeKeywords()
 
 	// Get role from plan
 	toGrant := scylladb.Grant{
@@ -211,16 +387,20 @@ func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Identifier:   plan.Identifier.ValueString(),
 	}
 
-	err := g.client.UpdateGrant(fromGrant, toGrant)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Grant",
-			err.Error(),
-		)
-		return
+	// Only re-grant when the grant itself changed; changing adopt_existing alone is state-only.
+	client := g.clusterFor(ctx, plan)
+	if fromGrant != toGrant {
+		err := client.UpdateGrant(fromGrant, toGrant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Grant",
+				err.Error(),
+			)
+			return
+		}
 	}
 
-	newPermissions, err := g.client.GetGrantPermissions(toGrant)
+	newPermissions, err := readNewGrantPermissions(client, toGrant, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Grant Permissions",
@@ -235,7 +415,8 @@ func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	plan.Permissions = permissionsList
 	// Populate Compuated attribute values
-	plan.ID = types.StringValue(fmt.Sprintf("%s|%s|%s|%s|%s", toGrant.RoleName, toGrant.Privilege, toGrant.ResourceType, toGrant.Keyspace, toGrant.Identifier))
+	plan.ID = types.StringValue(grantID(toGrant))
+	plan.ImportID = plan.ID
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -243,7 +424,31 @@ func (g *grantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 }
 
+// readNewGrantPermissions reads the permissions of a grant that was just created, retrying while
+// they are not visible yet. When they still are not, it warns and returns what was read, since the
+// GRANT itself succeeded.
+func readNewGrantPermissions(client *scylladb.Cluster, grant scylladb.Grant, diags *diag.Diagnostics) ([]string, error) {
+	permissions, visible, err := client.ReadGrantPermissions(grant)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		diags.AddWarning(
+			"Grant Permissions Not Visible Yet",
+			fmt.Sprintf("The %s privilege of role %q did not show up after %d reads, most likely because of the permissions cache of the cluster. "+
+				"The stored permissions may be incomplete until the next refresh; increase grant_verify_attempts in the provider configuration if this persists.",
+				grant.Privilege, grant.RoleName, client.GrantVerifyAttempts),
+		)
+	}
+	return permissions, nil
+}
+
 func (g *grantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(g.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state grantResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -259,7 +464,24 @@ func (g *grantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		Keyspace:     state.Keyspace.ValueString(),
 		Identifier:   state.Identifier.ValueString(),
 	}
-	err := g.client.DeleteGrant(grant)
+	recorded := []string{}
+	resp.Diagnostics.Append(state.Permissions.ElementsAs(ctx, &recorded, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoke what the grant recorded rather than the privilege keyword, so that a blanket
+	// REVOKE ALL PERMISSIONS does not take away permissions other grants gave. Without any
+	// recorded permissions, e.g. when they were not visible yet after the GRANT, fall back to the
+	// privilege.
+	client := g.clusterFor(ctx, state)
+	var err error
+	if own := grant.OwnPermissions(recorded); !state.RevokeAllOnDelete.ValueBool() && len(own) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Revoking recorded permissions: %v", own))
+		err = client.DeleteGrantPermissions(grant, own)
+	} else {
+		err = client.DeleteGrant(grant)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Grant",
@@ -283,13 +505,13 @@ func (g *grantResource) ImportState(ctx context.Context, req resource.ImportStat
 
 	grant := scylladb.Grant{
 		RoleName:     parts[0],
-		Privilege:    parts[1],
-		ResourceType: parts[2],
+		Privilege:    strings.ToUpper(parts[1]),
+		ResourceType: strings.ToUpper(parts[2]),
 		Keyspace:     parts[3],
 		Identifier:   parts[4],
 	}
 
-	dbPermissions, err := g.client.GetGrantPermissions(grant)
+	dbPermissions, err := g.client.WithContext(ctx).GetGrantPermissions(grant)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Grant",
@@ -303,10 +525,12 @@ func (g *grantResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	// The ID is built like Create builds it, so that an ID with a lowercase privilege matches
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), grantID(grant))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("import_id"), grantID(grant))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_name"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("privilege"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("privilege"), grant.Privilege)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), grant.ResourceType)...)
 	if parts[3] != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keyspace"), parts[3])...)
 		if parts[4] != "" {
@@ -314,14 +538,47 @@ func (g *grantResource) ImportState(ctx context.Context, req resource.ImportStat
 		}
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permissions"), permissionsList)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), true)...)
+	// An imported grant on a system keyspace exists already, so the configuration has to allow it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_system_keyspace_grants"), scylladb.IsSystemKeyspace(parts[3]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cross_check_permissions"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revoke_all_on_delete"), false)...)
+
+}
+
+// clusterFor returns the client of the provider bound to the context of the operation, reading
+// from the system_auth_keyspace of model when it is set.
+func (g *grantResource) clusterFor(ctx context.Context, model grantResourceModel) *scylladb.Cluster {
+	return g.client.WithSystemAuthKeyspace(model.SystemAuthKeyspace.ValueString()).WithContext(ctx)
+}
+
+// normalizeKeywords uppercases the privilege and resource type, as the database reports them, so
+// that the grants built from m, their IDs, and the state do not depend on the configured case.
+// Their values compare case-insensitively, so the configuration does not have to change.
+func (m *grantResourceModel) normalizeKeywords() {
+	if !m.Privilege.IsUnknown() && !m.Privilege.IsNull() {
+		m.Privilege = upperCaseString(m.Privilege.ValueString())
+	}
+	if !m.ResourceType.IsUnknown() && !m.ResourceType.IsNull() {
+		m.ResourceType = upperCaseString(m.ResourceType.ValueString())
+	}
+}
 
+// grantID returns the ID of the grant, which is also the ID ImportState accepts.
+func grantID(grant scylladb.Grant) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s", grant.RoleName, grant.Privilege, grant.ResourceType, grant.Keyspace, grant.Identifier)
 }
 
 // ModifyPlan checks if the grant remains the same by checking the current permissions with the state permissions
 // If the permissions was modified externally, the resource is marked for replacement.
+// A grant being created is checked for redundancy instead.
 func (g *grantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Skip if resource is being created or destroyed
-	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+	// Skip if resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		g.warnRedundantGrant(ctx, req, resp)
 		return
 	}
 
@@ -339,7 +596,7 @@ func (g *grantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		Keyspace:     state.Keyspace.ValueString(),
 		Identifier:   state.Identifier.ValueString(),
 	}
-	dbPermissions, err := g.client.GetGrantPermissions(grant)
+	dbPermissions, err := g.clusterFor(ctx, state).GetGrantPermissions(grant)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Grant",
@@ -357,8 +614,12 @@ func (g *grantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("got permissions: from db = %v | from state = %v", dbPermissions, statePermissions))
-	// Compare the permissions. If not the same, update the plan's permission, which causes it to replace
-	if slices.Compare(dbPermissions, statePermissions) == 0 {
+	// Compare only the permissions the privilege expands to, e.g. all five table permissions for
+	// ALL PERMISSIONS on a table, so that permissions other grants give the role on the same
+	// resource do not count as drift. OwnPermissions also sorts them, since state written by older
+	// versions may not be sorted. If not the same, update the plan's permission, which causes it
+	// to replace
+	if slices.Equal(grant.OwnPermissions(dbPermissions), grant.OwnPermissions(statePermissions)) {
 		return
 	}
 
@@ -384,3 +645,47 @@ func (g *grantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("permissions"))
 }
+
+// warnRedundantGrant warns when the role of a grant being created already holds its privilege
+// through the roles it is a member of or on the resources enclosing the resource, since the grant
+// then only adds noise. It never blocks the plan: when the role or the permissions cannot be read,
+// e.g. because the role is created in the same apply, nothing is reported.
+func (g *grantResource) warnRedundantGrant(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if g.client == nil {
+		return
+	}
+	var plan grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, value := range []types.String{plan.RoleName, plan.Privilege.StringValue, plan.ResourceType.StringValue, plan.Keyspace, plan.Identifier, plan.SystemAuthKeyspace} {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	grant := scylladb.Grant{
+		RoleName:     plan.RoleName.ValueString(),
+		Privilege:    plan.Privilege.ValueString(),
+		ResourceType: plan.ResourceType.ValueString(),
+		Keyspace:     plan.Keyspace.ValueString(),
+		Identifier:   plan.Identifier.ValueString(),
+	}
+	inherited, err := g.clusterFor(ctx, plan).GetRoleInheritedPermissions(grant.RoleName, grant)
+	if err != nil {
+		tflog.Debug(ctx, "unable to check whether the grant is redundant", map[string]any{"error": err.Error()})
+		return
+	}
+	for _, permission := range grant.GetExpandedPermissions() {
+		if !slices.Contains(inherited, permission) {
+			return
+		}
+	}
+	resp.Diagnostics.AddWarning(
+		"Redundant Grant",
+		fmt.Sprintf("The role %q already holds %s on the %s resource through the roles it is a member of or the resources enclosing it. "+
+			"The grant will be applied, but it can be removed to keep grants minimal.",
+			grant.RoleName, strings.ToUpper(grant.Privilege), strings.ToUpper(grant.ResourceType)),
+	)
+}
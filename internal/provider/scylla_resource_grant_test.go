@@ -3,11 +3,19 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
 	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
@@ -46,6 +54,8 @@ resource "scylladb_grant" "admin_alter_keyspace" {
 					resource.TestCheckNoResourceAttr("scylladb_grant.admin_alter_keyspace", "identifier"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("scylladb_grant.admin_alter_keyspace", "id"),
+					resource.TestCheckResourceAttr("scylladb_grant.admin_alter_keyspace", "import_id", "admin|ALTER|KEYSPACE|cycling|"),
+					resource.TestCheckResourceAttrPair("scylladb_grant.admin_alter_keyspace", "import_id", "scylladb_grant.admin_alter_keyspace", "id"),
 				),
 			},
 			// ImportState testing
@@ -54,6 +64,13 @@ resource "scylladb_grant" "admin_alter_keyspace" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// An ID with lowercase keywords imports the same state
+			{
+				ResourceName:      "scylladb_grant.admin_alter_keyspace",
+				ImportState:       true,
+				ImportStateId:     "admin|alter|keyspace|cycling|",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -76,6 +93,7 @@ resource "scylladb_grant" "admin_alter_cyclist_name" {
 					resource.TestCheckResourceAttr("scylladb_grant.admin_alter_cyclist_name", "resource_type", "TABLE"),
 					resource.TestCheckResourceAttr("scylladb_grant.admin_alter_cyclist_name", "keyspace", "cycling"),
 					resource.TestCheckResourceAttr("scylladb_grant.admin_alter_cyclist_name", "identifier", "cyclist_name"),
+					resource.TestCheckResourceAttrPair("scylladb_grant.admin_alter_cyclist_name", "import_id", "scylladb_grant.admin_alter_cyclist_name", "id"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -145,3 +163,400 @@ resource "scylladb_grant" "admin_alter_keyspace" {
 		},
 	})
 }
+
+func TestAccGrantResourceAdoptExisting(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	// Create the role and grant outside of Terraform
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateRole(scylladb.Role{Role: "adopter"}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	if err := cluster.CreateGrant(scylladb.Grant{
+		RoleName:     "adopter",
+		Privilege:    "SELECT",
+		ResourceType: "KEYSPACE",
+		Keyspace:     "cycling",
+	}); err != nil {
+		t.Fatalf("failed to create grant: %s", err)
+	}
+
+	grantConfigFmt := providerConfig + `
+resource "scylladb_grant" "adopted" {
+  role_name      = "adopter"
+  privilege      = "SELECT"
+  resource_type  = "KEYSPACE"
+  keyspace       = "cycling"
+  adopt_existing = %t
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Refusing to adopt reports the existing grant
+			{
+				Config:      fmt.Sprintf(grantConfigFmt, false),
+				ExpectError: regexp.MustCompile(`Grant Already Exists`),
+			},
+			// Adopting produces clean state without an import
+			{
+				Config: fmt.Sprintf(grantConfigFmt, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_grant.adopted", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("scylladb_grant.adopted", "permissions.0", "SELECT"),
+				),
+			},
+			{
+				Config:   fmt.Sprintf(grantConfigFmt, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccGrantResourceSystemKeyspace(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	grantConfigFmt := providerConfig + `
+resource "scylladb_role" "monitoring" {
+  role      = "monitoring"
+  can_login = false
+}
+resource "scylladb_grant" "monitoring_system" {
+  role_name     = scylladb_role.monitoring.role
+  privilege     = "SELECT"
+  resource_type = "KEYSPACE"
+  keyspace      = "system"
+  %s
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Granting on a system keyspace is refused by default
+			{
+				Config:      fmt.Sprintf(grantConfigFmt, ""),
+				ExpectError: regexp.MustCompile(`System Keyspace Grant Not Allowed`),
+				PlanOnly:    true,
+			},
+			// and allowed when opted into
+			{
+				Config: fmt.Sprintf(grantConfigFmt, "allow_system_keyspace_grants = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_grant.monitoring_system", "keyspace", "system"),
+					resource.TestCheckResourceAttr("scylladb_grant.monitoring_system", "allow_system_keyspace_grants", "true"),
+					resource.TestCheckResourceAttr("scylladb_grant.monitoring_system", "permissions.0", "SELECT"),
+				),
+			},
+			{
+				ResourceName:      "scylladb_grant.monitoring_system",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrantResourceAllRoles(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	grantConfigFmt := providerConfig + `
+resource "scylladb_role" "role_admin" {
+  role      = "role_admin"
+  can_login = false
+}
+resource "scylladb_grant" "role_admin" {
+  role_name     = scylladb_role.role_admin.role
+  privilege     = "%s"
+  resource_type = "ALL ROLES"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(grantConfigFmt, "AUTHORIZE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_grant.role_admin", "resource_type", "ALL ROLES"),
+					resource.TestCheckResourceAttr("scylladb_grant.role_admin", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("scylladb_grant.role_admin", "permissions.0", "AUTHORIZE"),
+				),
+			},
+			{
+				ResourceName:      "scylladb_grant.role_admin",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// SELECT does not apply to roles and is refused at plan time
+			{
+				Config:      fmt.Sprintf(grantConfigFmt, "SELECT"),
+				ExpectError: regexp.MustCompile(`Privilege Not Applicable`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+// TestAccGrantResourceDeleteRecordedPermissions verifies that destroying a grant revokes only the
+// permissions it recorded, even after they were narrowed outside of Terraform, and leaves the
+// permissions other grants gave the role in place.
+func TestAccGrantResourceDeleteRecordedPermissions(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateRole(scylladb.Role{Role: "narrowed"}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	// SELECT on the table comes from outside of Terraform
+	tableGrant := scylladb.Grant{RoleName: "narrowed", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}
+	selectGrant := tableGrant
+	selectGrant.Privilege = "SELECT"
+	if err := cluster.CreateGrant(selectGrant); err != nil {
+		t.Fatalf("failed to create grant: %s", err)
+	}
+
+	grantConfig := providerConfig + `
+resource "scylladb_grant" "all" {
+  role_name     = "narrowed"
+  privilege     = "ALL PERMISSIONS"
+  resource_type = "KEYSPACE"
+  keyspace      = "cycling"
+}
+resource "scylladb_grant" "alter" {
+  role_name     = "narrowed"
+  privilege     = "ALTER"
+  resource_type = "TABLE"
+  keyspace      = "cycling"
+  identifier    = "cyclist_name"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: grantConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_grant.all", "permissions.#", "6"),
+					resource.TestCheckResourceAttr("scylladb_grant.alter", "permissions.#", "2"),
+				),
+			},
+			// Narrow the keyspace grant outside of Terraform, then destroy both grants
+			{
+				PreConfig: func() {
+					if err := cluster.DeleteGrant(scylladb.Grant{RoleName: "narrowed", Privilege: "MODIFY", ResourceType: "KEYSPACE", Keyspace: "cycling"}); err != nil {
+						t.Fatalf("failed to narrow grant: %s", err)
+					}
+				},
+				Config: providerConfig,
+				Check: func(_ *terraform.State) error {
+					keyspacePermissions, err := cluster.GetRolePermissions(scylladb.Grant{RoleName: "narrowed", ResourceType: "KEYSPACE", Keyspace: "cycling"})
+					if err != nil {
+						return err
+					}
+					if len(keyspacePermissions) != 0 {
+						return fmt.Errorf("expected no keyspace permissions left, got %v", keyspacePermissions)
+					}
+					tablePermissions, err := cluster.GetRolePermissions(tableGrant)
+					if err != nil {
+						return err
+					}
+					if !slices.Equal(tablePermissions, []string{"SELECT"}) {
+						return fmt.Errorf("expected the external SELECT to be kept, got %v", tablePermissions)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// TestAccGrantResourceAllPermissionsNoDrift verifies that ALL PERMISSIONS is recorded as the
+// permissions it expands to, so the next plan is empty.
+func TestAccGrantResourceAllPermissionsNoDrift(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	grantConfig := providerConfig + `
+resource "scylladb_role" "owner" {
+  role = "owner"
+}
+resource "scylladb_grant" "all" {
+  role_name     = scylladb_role.owner.role
+  privilege     = "ALL PERMISSIONS"
+  resource_type = "TABLE"
+  keyspace      = "cycling"
+  identifier    = "cyclist_name"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: grantConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_grant.all", "permissions.#", "5"),
+					resource.TestCheckResourceAttr("scylladb_grant.all", "permissions.0", "ALTER"),
+					resource.TestCheckResourceAttr("scylladb_grant.all", "permissions.4", "SELECT"),
+				),
+			},
+			// The expanded permissions match the database, so nothing is replaced
+			{
+				Config: grantConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestGrantResourceRedundantWarning verifies that planning a grant the role already holds through
+// a parent role warns about it without failing the plan.
+func TestGrantResourceRedundantWarning(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateRole(scylladb.Role{Role: "readers"}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	if err := cluster.CreateRole(scylladb.Role{Role: "analyst", MemberOf: []string{"readers"}}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+	if err := cluster.CreateGrant(scylladb.Grant{RoleName: "readers", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"}); err != nil {
+		t.Fatalf("failed to create grant: %s", err)
+	}
+
+	ctx := context.Background()
+	g := &grantResource{client: cluster}
+	var schemaResp fwresource.SchemaResponse
+	g.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	planFor := func(privilege string) fwresource.ModifyPlanResponse {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		if diags := plan.Set(ctx, &grantResourceModel{
+			RoleName:     types.StringValue("analyst"),
+			Privilege:    caseInsensitiveString{StringValue: types.StringValue(privilege)},
+			ResourceType: caseInsensitiveString{StringValue: types.StringValue("TABLE")},
+			Keyspace:     types.StringValue("cycling"),
+			Identifier:   types.StringValue("cyclist_name"),
+			Permissions:  types.ListUnknown(types.StringType),
+		}); diags.HasError() {
+			t.Fatalf("failed to set plan: %v", diags)
+		}
+		req := fwresource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		g.ModifyPlan(ctx, req, &resp)
+		return resp
+	}
+
+	resp := planFor("SELECT")
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Redundant Grant" {
+		t.Errorf("expected a single Redundant Grant warning, got %v", resp.Diagnostics)
+	}
+	if resp = planFor("MODIFY"); len(resp.Diagnostics) > 0 {
+		t.Errorf("expected no diagnostics for a grant that is not inherited, got %v", resp.Diagnostics)
+	}
+}
+
+// TestGrantResourceCreateUppercase verifies that a grant configured in lowercase is stored with
+// the uppercase privilege and resource type the database reports.
+func TestGrantResourceCreateUppercase(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateRole(scylladb.Role{Role: "lowercase"}); err != nil {
+		t.Fatalf("failed to create role: %s", err)
+	}
+
+	ctx := context.Background()
+	g := &grantResource{client: cluster}
+	var schemaResp fwresource.SchemaResponse
+	g.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &grantResourceModel{
+		ID:           types.StringUnknown(),
+		ImportID:     types.StringUnknown(),
+		RoleName:     types.StringValue("lowercase"),
+		Privilege:    caseInsensitiveString{StringValue: types.StringValue("select")},
+		ResourceType: caseInsensitiveString{StringValue: types.StringValue("table")},
+		Keyspace:     types.StringValue("cycling"),
+		Identifier:   types.StringValue("cyclist_name"),
+		Permissions:  types.ListUnknown(types.StringType),
+	}); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	g.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to create grant: %v", resp.Diagnostics)
+	}
+
+	var state grantResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("failed to get state: %v", diags)
+	}
+	if state.Privilege.ValueString() != "SELECT" || state.ResourceType.ValueString() != "TABLE" {
+		t.Errorf("expected privilege SELECT on TABLE in state, got %s on %s", state.Privilege.ValueString(), state.ResourceType.ValueString())
+	}
+	if want := "lowercase|SELECT|TABLE|cycling|cyclist_name"; state.ID.ValueString() != want {
+		t.Errorf("expected ID %s, got %s", want, state.ID.ValueString())
+	}
+}
+
+func TestCaseInsensitiveString(t *testing.T) {
+	ctx := context.Background()
+	value, err := caseInsensitiveStringType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "select"))
+	if err != nil {
+		t.Fatalf("failed to convert value: %s", err)
+	}
+	lower, ok := value.(caseInsensitiveString)
+	if !ok {
+		t.Fatalf("expected a caseInsensitiveString, got %T", value)
+	}
+	upper := upperCaseString("select")
+	if upper.ValueString() != "SELECT" {
+		t.Errorf("expected SELECT, got %s", upper.ValueString())
+	}
+	if lower.Equal(upper) {
+		t.Error("values that differ in case are not equal")
+	}
+	if equal, diags := lower.StringSemanticEquals(ctx, upper); diags.HasError() || !equal {
+		t.Errorf("expected select and SELECT to be semantically equal, got %t %v", equal, diags)
+	}
+	if equal, _ := lower.StringSemanticEquals(ctx, upperCaseString("modify")); equal {
+		t.Error("expected select and MODIFY to differ")
+	}
+}
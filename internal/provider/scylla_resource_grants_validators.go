@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -43,3 +44,44 @@ func (v uniqueGrantRolesValidator) ValidateSet(ctx context.Context, req validato
 		seen[role] = true
 	}
 }
+
+// uniqueRoleGrantResourcesValidator rejects a role grant set that has more than one block for the
+// same resource. The grants of a role are read back as one block per resource, so split blocks
+// would show a difference on every plan.
+type uniqueRoleGrantResourcesValidator struct{}
+
+func (uniqueRoleGrantResourcesValidator) Description(_ context.Context) string {
+	return "Each resource must appear in only one grant block."
+}
+
+func (uniqueRoleGrantResourcesValidator) MarkdownDescription(_ context.Context) string {
+	return "Each resource must appear in only one grant block."
+}
+
+func (v uniqueRoleGrantResourcesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	var grants []roleGrantModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &grants, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	seen := make(map[string]bool, len(grants))
+	for _, g := range grants {
+		if g.ResourceType.IsUnknown() || g.Keyspace.IsUnknown() || g.Identifier.IsUnknown() {
+			continue
+		}
+		resource := strings.TrimSpace(strings.Join([]string{
+			strings.ToUpper(g.ResourceType.ValueString()),
+			g.Keyspace.ValueString(),
+			g.Identifier.ValueString(),
+		}, " "))
+		if seen[resource] {
+			resp.Diagnostics.AddAttributeError(req.Path, "Duplicate Grant Resource",
+				fmt.Sprintf("The resource %s appears in more than one grant block. Merge their privileges into a single block.", resource))
+			return
+		}
+		seen[resource] = true
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+var _ resource.Resource = &keyspaceResource{}
+var _ resource.ResourceWithConfigure = &keyspaceResource{}
+var _ resource.ResourceWithImportState = &keyspaceResource{}
+var _ resource.ResourceWithValidateConfig = &keyspaceResource{}
+var _ resource.ResourceWithModifyPlan = &keyspaceResource{}
+
+func NewKeyspaceResource() resource.Resource {
+	return &keyspaceResource{}
+}
+
+type keyspaceResource struct {
+	client *scylladb.Cluster
+}
+
+type keyspaceResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ReplicationClass  types.String `tfsdk:"replication_class"`
+	ReplicationFactor types.Int64  `tfsdk:"replication_factor"`
+	Datacenters       types.Map    `tfsdk:"datacenters"`
+	DurableWrites     types.Bool   `tfsdk:"durable_writes"`
+
+	ConfirmNonEmptyDrop types.Bool `tfsdk:"confirm_non_empty_drop"`
+}
+
+func (r *keyspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keyspace"
+}
+
+func (r *keyspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a keyspace. Use `scylladb_schema` instead to manage a keyspace together with its tables.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The keyspace name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the keyspace. The name is quoted, so its case is preserved.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"replication_class": schema.StringAttribute{
+				Description: "The replication strategy of the keyspace (SimpleStrategy or NetworkTopologyStrategy). Defaults to SimpleStrategy.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SimpleStrategy"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("SimpleStrategy", scylladb.NetworkTopologyStrategy),
+				},
+			},
+			"replication_factor": schema.Int64Attribute{
+				Description: "The replication factor. Required with SimpleStrategy.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"datacenters": schema.MapAttribute{
+				Description: "The replication factor of each datacenter. Required with NetworkTopologyStrategy.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"durable_writes": schema.BoolAttribute{
+				Description: "Whether writes to the keyspace go through the commit log. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"confirm_non_empty_drop": schema.BoolAttribute{
+				Description: "Whether the keyspace may be dropped, on destroy or replacement, while it still has tables. " +
+					"Without it, such a drop fails and lists the tables; set it and apply before destroying the keyspace. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *keyspaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig checks that the replication settings match the replication class.
+func (r *keyspaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config keyspaceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateReplicationConfig(config.ReplicationClass, config.ReplicationFactor, config.Datacenters)...)
+}
+
+// ModifyPlan refuses to plan dropping a keyspace that still has tables unless
+// confirm_non_empty_drop is set, and warns about the tables that will be dropped when it is.
+func (r *keyspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is dropped on create, or checked before the provider is configured.
+	if req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var state keyspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.Plan.Raw.IsNull() {
+		var plan keyspaceResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Only a new name replaces, and thereby drops, the keyspace.
+		if plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+			return
+		}
+	}
+	resp.Diagnostics.Append(r.checkNonEmptyDrop(ctx, state)...)
+}
+
+func (r *keyspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+	var plan keyspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ks, diags := plan.keyspace(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := client.CheckReplicationDatacenters(ks, true); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("datacenters"), "Error Creating Keyspace", err.Error())
+		return
+	}
+	if err := client.CreateKeyspaceStrict(ks); err != nil {
+		if errors.Is(err, scylladb.ErrKeyspaceAlreadyExists) {
+			resp.Diagnostics.AddError("Keyspace Already Exists",
+				fmt.Sprintf("The keyspace %s already exists. Import it with terraform import to manage it with this resource.", ks.Name))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Keyspace", err.Error())
+		return
+	}
+	// Grants and tables created next may be coordinated by another node
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Creating Keyspace", err.Error())
+		return
+	}
+
+	state, diags := r.readKeyspace(ctx, ks.Name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		resp.Diagnostics.AddError("Error Creating Keyspace", fmt.Sprintf("The keyspace %s disappeared after it was created.", ks.Name))
+		return
+	}
+	state.ConfirmNonEmptyDrop = plan.ConfirmNonEmptyDrop
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *keyspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state keyspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After an import only the ID is set
+	current, diags := r.readKeyspace(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if current == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !state.ConfirmNonEmptyDrop.IsNull() {
+		current.ConfirmNonEmptyDrop = state.ConfirmNonEmptyDrop
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, current)...)
+}
+
+func (r *keyspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+	var plan keyspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ks, diags := plan.keyspace(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior keyspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, diags := prior.keyspace(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the changed options are altered, so that toggling durable_writes does not re-send the
+	// replication
+	changes := scylladb.KeyspaceChangesBetween(current, ks)
+	// Altering the replication in place keeps the data, where a replacement would drop it
+	if changes.Replication {
+		if _, err := client.CheckReplicationDatacenters(ks, true); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("datacenters"), "Error Updating Keyspace", err.Error())
+			return
+		}
+	}
+	if err := client.AlterKeyspaceOptions(ks, changes); err != nil {
+		resp.Diagnostics.AddError("Error Updating Keyspace", err.Error())
+		return
+	}
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Updating Keyspace", err.Error())
+		return
+	}
+
+	state, diags := r.readKeyspace(ctx, ks.Name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		resp.Diagnostics.AddError("Error Updating Keyspace", fmt.Sprintf("The keyspace %s disappeared during the update.", ks.Name))
+		return
+	}
+	state.ConfirmNonEmptyDrop = plan.ConfirmNonEmptyDrop
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *keyspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state keyspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tables may have been created since the plan
+	resp.Diagnostics.Append(r.checkNonEmptyDrop(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.WithContext(ctx).DeleteKeyspace(scylladb.Keyspace{Name: state.Name.ValueString()}); err != nil {
+		resp.Diagnostics.AddError("Error Dropping Keyspace", err.Error())
+		return
+	}
+}
+
+func (r *keyspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkNonEmptyDrop returns an error listing the tables of the keyspace of state when there are
+// any and confirm_non_empty_drop is not set, and a warning listing them when it is.
+func (r *keyspaceResource) checkNonEmptyDrop(ctx context.Context, state keyspaceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tables, err := r.client.WithContext(ctx).ListTableNames(state.Name.ValueString())
+	if err != nil {
+		diags.AddError("Error Reading Tables", err.Error())
+		return diags
+	}
+	if len(tables) == 0 {
+		return diags
+	}
+	if !state.ConfirmNonEmptyDrop.ValueBool() {
+		diags.AddError("Keyspace Is Not Empty",
+			fmt.Sprintf("The keyspace %s still has the tables %s, which would be dropped along with their data. "+
+				"Set confirm_non_empty_drop = true and apply it before dropping the keyspace.", state.Name.ValueString(), strings.Join(tables, ", ")))
+		return diags
+	}
+	diags.AddWarning("Dropping Non-Empty Keyspace",
+		fmt.Sprintf("The keyspace %s is dropped together with the tables %s and their data.", state.Name.ValueString(), strings.Join(tables, ", ")))
+	return diags
+}
+
+// readKeyspace reads the keyspace from system_schema.keyspaces, or returns nil when it does not
+// exist.
+func (r *keyspaceResource) readKeyspace(ctx context.Context, name string) (*keyspaceResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ks, err := r.client.WithContext(ctx).GetKeyspace(name)
+	if errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError("Error Reading Keyspace", err.Error())
+		return nil, diags
+	}
+
+	model := &keyspaceResourceModel{
+		ID:                types.StringValue(ks.Name),
+		Name:              types.StringValue(ks.Name),
+		ReplicationClass:  types.StringValue(ks.ReplicationClass),
+		ReplicationFactor: types.Int64Null(),
+		Datacenters:       types.MapNull(types.Int64Type),
+		DurableWrites:     types.BoolValue(ks.DurableWrites),
+
+		ConfirmNonEmptyDrop: types.BoolValue(false),
+	}
+	if ks.ReplicationClass == scylladb.NetworkTopologyStrategy {
+		datacenters, mapDiags := types.MapValueFrom(ctx, types.Int64Type, ks.DatacenterReplication)
+		diags.Append(mapDiags...)
+		model.Datacenters = datacenters
+	} else {
+		model.ReplicationFactor = types.Int64Value(int64(ks.ReplicationFactor))
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return model, diags
+}
+
+// keyspace converts the model to the keyspace it describes.
+func (m keyspaceResourceModel) keyspace(ctx context.Context) (scylladb.Keyspace, diag.Diagnostics) {
+	ks := scylladb.Keyspace{
+		Name:              m.Name.ValueString(),
+		ReplicationClass:  m.ReplicationClass.ValueString(),
+		ReplicationFactor: int(m.ReplicationFactor.ValueInt64()),
+		DurableWrites:     m.DurableWrites.ValueBool(),
+	}
+	var diags diag.Diagnostics
+	if !m.Datacenters.IsNull() {
+		var datacenters map[string]int64
+		diags.Append(m.Datacenters.ElementsAs(ctx, &datacenters, false)...)
+		ks.DatacenterReplication = make(map[string]int, len(datacenters))
+		for dc, factor := range datacenters {
+			ks.DatacenterReplication[dc] = int(factor)
+		}
+	}
+	return ks, diags
+}
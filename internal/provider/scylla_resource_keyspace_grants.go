@@ -134,7 +134,7 @@ func (r *keyspaceGrantsResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 	id := scylladb.ParseIdentifier(state.Keyspace.ValueString())
-	roleBindings, err := r.client.GetAllRoleBindingsPerId(id)
+	roleBindings, err := r.client.WithContext(ctx).GetAllRoleBindingsPerId(id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Keyspace Grants", err.Error())
 		return
@@ -166,6 +166,11 @@ func (r *keyspaceGrantsResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *keyspaceGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state keyspaceGrantsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -174,7 +179,7 @@ func (r *keyspaceGrantsResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	id := scylladb.ParseIdentifier(state.Keyspace.ValueString())
-	if err := r.client.RevokeAllGrantsOnIdentifier(id); err != nil {
+	if err := r.client.WithContext(ctx).RevokeAllGrantsOnIdentifier(id); err != nil {
 		resp.Diagnostics.AddError("Error Revoking Keyspace Grants", err.Error())
 	}
 }
@@ -185,7 +190,7 @@ func (r *keyspaceGrantsResource) ImportState(ctx context.Context, req resource.I
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a keyspace identifier, got %q", req.ID))
 		return
 	}
-	permissionMap, err := r.client.GetAllRolePermissionsPerId(id)
+	permissionMap, err := r.client.WithContext(ctx).GetAllRolePermissionsPerId(id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Importing Keyspace Grants", err.Error())
 		return
@@ -225,7 +230,7 @@ func (r *keyspaceGrantsResource) applyPlanData(ctx context.Context, plan *keyspa
 		return
 	}
 
-	if err := r.client.ApplyAuthoritativeGrant(id, bindings); err != nil {
+	if err := r.client.WithContext(ctx).ApplyAuthoritativeGrant(id, bindings); err != nil {
 		diags.AddError("Error Applying Keyspace Grants", err.Error())
 		return
 	}
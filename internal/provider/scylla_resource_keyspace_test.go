@@ -0,0 +1,323 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccKeyspaceResource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_keyspace" "touring" {
+  name               = "touring"
+  replication_factor = 1
+}
+`
+
+	alteredConfig := providerConfig + `
+resource "scylladb_keyspace" "touring" {
+  name               = "touring"
+  replication_factor = 2
+  durable_writes     = false
+}
+`
+	renamedConfig := providerConfig + `
+resource "scylladb_keyspace" "touring" {
+  name               = "touring_renamed"
+  replication_factor = 2
+  durable_writes     = false
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			cluster, err := getTestScyllaClient([]string{devClusterHost})
+			if err != nil {
+				return fmt.Errorf("failed to create cluster client: %w", err)
+			}
+			defer cluster.Session.Close()
+			for _, name := range []string{"touring", "touring_renamed"} {
+				if _, err := cluster.GetKeyspace(name); !errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+					return fmt.Errorf("expected keyspace %s to be dropped, got %v", name, err)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create the keyspace
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "id", "touring"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "replication_class", "SimpleStrategy"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "replication_factor", "1"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "durable_writes", "true"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scylladb_keyspace.touring",
+				ImportState:       true,
+				ImportStateId:     "touring",
+				ImportStateVerify: true,
+			},
+			// Externally disable durable writes, verify the drift is detected and reverted in place
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster client: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.Session.Query(`ALTER KEYSPACE touring WITH durable_writes = false`).Exec(); err != nil {
+						t.Fatalf("failed to alter the keyspace externally: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_keyspace.touring", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("scylladb_keyspace.touring", "durable_writes", "true"),
+			},
+			// Change the replication factor and durable writes in place
+			{
+				Config: alteredConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_keyspace.touring", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "replication_factor", "2"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "durable_writes", "false"),
+				),
+			},
+			// Keyspaces cannot be renamed, so a new name replaces the keyspace
+			{
+				Config: renamedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_keyspace.touring", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("scylladb_keyspace.touring", "id", "touring_renamed"),
+			},
+		},
+	})
+}
+
+// TestAccKeyspaceResourceMixedCaseName verifies that a keyspace name is quoted, so a mixed-case
+// name is created, read and imported with its case preserved.
+func TestAccKeyspaceResourceMixedCaseName(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_keyspace" "touring" {
+  name               = "TouringEvents"
+  replication_factor = 1
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			cluster, err := getTestScyllaClient([]string{devClusterHost})
+			if err != nil {
+				return fmt.Errorf("failed to create cluster client: %w", err)
+			}
+			defer cluster.Session.Close()
+			if _, err := cluster.GetKeyspace("TouringEvents"); !errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+				return fmt.Errorf("expected keyspace TouringEvents to be dropped, got %v", err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "id", "TouringEvents"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "name", "TouringEvents"),
+					func(_ *terraform.State) error {
+						cluster, err := getTestScyllaClient([]string{devClusterHost})
+						if err != nil {
+							return fmt.Errorf("failed to create cluster client: %w", err)
+						}
+						defer cluster.Session.Close()
+						if _, err := cluster.GetKeyspace("touringevents"); !errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+							return fmt.Errorf("expected the keyspace name to keep its case, got %v", err)
+						}
+						return nil
+					},
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scylladb_keyspace.touring",
+				ImportState:       true,
+				ImportStateId:     "TouringEvents",
+				ImportStateVerify: true,
+			},
+			// The keyspace reads back unchanged
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccKeyspaceResourceExternallyDeleted verifies that when a keyspace is dropped outside of
+// Terraform, running plan does not error out and instead plans to recreate the keyspace.
+func TestAccKeyspaceResourceExternallyDeleted(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_keyspace" "touring" {
+  name               = "touring"
+  replication_factor = 1
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the keyspace
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("scylladb_keyspace.touring", "name", "touring"),
+			},
+			// Drop the keyspace externally, then verify plan recovers without error and plans to recreate
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster config: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.DeleteKeyspace(scylladb.Keyspace{Name: "touring"}); err != nil {
+						t.Fatalf("failed to drop keyspace externally: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_keyspace.touring", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "name", "touring"),
+					resource.TestCheckResourceAttr("scylladb_keyspace.touring", "replication_factor", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccKeyspaceResourceIdempotentDDL verifies that with idempotent_ddl the resource adopts a
+// keyspace that already exists instead of failing.
+func TestAccKeyspaceResourceIdempotentDDL(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+	if err := cluster.CreateKeyspace(scylladb.Keyspace{Name: "adopted", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true}); err != nil {
+		t.Fatalf("failed to create the keyspace: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "scylladb" {
+  host           = %q
+  idempotent_ddl = true
+  auth_login_userpass {
+    username = "cassandra"
+    password = "cassandra"
+  }
+}
+
+resource "scylladb_keyspace" "adopted" {
+  name               = "adopted"
+  replication_factor = 1
+}
+`, devClusterHost),
+				Check: resource.TestCheckResourceAttr("scylladb_keyspace.adopted", "id", "adopted"),
+			},
+		},
+	})
+}
+
+// TestAccKeyspaceResourceNonEmptyDrop verifies that a keyspace with tables created outside of
+// Terraform is only dropped once confirm_non_empty_drop is set.
+func TestAccKeyspaceResourceNonEmptyDrop(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_keyspace" "racing" {
+  name               = "racing"
+  replication_factor = 1
+}
+`
+	confirmedConfig := providerConfig + `
+resource "scylladb_keyspace" "racing" {
+  name                   = "racing"
+  replication_factor     = 1
+  confirm_non_empty_drop = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("scylladb_keyspace.racing", "confirm_non_empty_drop", "false"),
+			},
+			// Refuse to drop the keyspace once an application created a table in it
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster client: %s", err)
+					}
+					defer cluster.Session.Close()
+					table := scylladb.Table{Keyspace: "racing", Name: "results", Columns: map[string]string{"id": "uuid"}, PartitionKey: []string{"id"}}
+					if err := cluster.CreateTable(table); err != nil {
+						t.Fatalf("failed to create the table: %s", err)
+					}
+				},
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`(?s)Keyspace Is Not Empty.*results`),
+			},
+			// The final destroy succeeds once confirmed
+			{
+				Config: confirmedConfig,
+				Check:  resource.TestCheckResourceAttr("scylladb_keyspace.racing", "confirm_non_empty_drop", "true"),
+			},
+		},
+	})
+}
@@ -6,14 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
 
@@ -21,6 +29,8 @@ import (
 var _ resource.Resource = &roleResource{}
 var _ resource.ResourceWithConfigure = &roleResource{}
 var _ resource.ResourceWithImportState = &roleResource{}
+var _ resource.ResourceWithModifyPlan = &roleResource{}
+var _ resource.ResourceWithValidateConfig = &roleResource{}
 
 func NewRoleResource() resource.Resource {
 	return &roleResource{}
@@ -34,10 +44,17 @@ type roleResource struct {
 // roleResourceModel maps the resource source schema data.
 type roleResourceModel struct {
 	ID          types.String `tfsdk:"id"`
+	Created     types.String `tfsdk:"created"`
 	Role        types.String `tfsdk:"role"`
 	CanLogin    types.Bool   `tfsdk:"can_login"`
 	IsSuperuser types.Bool   `tfsdk:"is_superuser"`
 	MemberOf    types.List   `tfsdk:"member_of"`
+	Password    types.String `tfsdk:"password"`
+	PasswordEnv types.String `tfsdk:"password_env"`
+
+	AuthoritativeMemberships types.Bool `tfsdk:"authoritative_memberships"`
+
+	SystemAuthKeyspace types.String `tfsdk:"system_auth_keyspace"`
 }
 
 // Metadata returns the resource type name.
@@ -58,6 +75,14 @@ func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(), // the attribute is not configurable and should not show updates from the existing state
 				},
 			},
+			"created": schema.StringAttribute{
+				Description: "When the role was created by Terraform, as an RFC 3339 timestamp. ScyllaDB does not record it, " +
+					"so it is null for imported roles",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"role": schema.StringAttribute{
 				Description: "The name of the role",
 				Required:    true,
@@ -75,9 +100,47 @@ func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:     booldefault.StaticBool(false),
 			},
 			"member_of": schema.ListAttribute{
-				Computed:    true,
-				Description: "a list of members of the role",
+				Computed: true,
+				Optional: true,
+				Description: "a list of roles the role is a member of. It can only be set together with authoritative_memberships, " +
+					"and is otherwise read from the database",
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "the password of the role. ScyllaDB never returns it, so it is kept from the configuration, and a change is applied in place. " +
+					"Removing it leaves the current password in place. Conflicts with password_env",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("password_env")),
+				},
+			},
+			"password_env": schema.StringAttribute{
+				Description: "the name of an environment variable holding the password of the role, which keeps the password out of plan and state files. " +
+					"The variable is read when the role is created or password_env changes; a new value of the same variable is not detected",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"authoritative_memberships": schema.BoolAttribute{
+				Description: "whether member_of is authoritative: the listed roles are granted to the role and any other role it was granted is revoked on apply. " +
+					"Requires member_of to be set",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"system_auth_keyspace": schema.StringAttribute{
+				Description: "the keyspace the role is read from, overriding the system_auth_keyspace of the provider. " +
+					"Only needed when roles live in different auth keyspaces",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 		},
 	}
@@ -103,6 +166,127 @@ func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ValidateConfig checks that member_of and authoritative_memberships are set together. Without
+// authoritative_memberships, member_of only reports the memberships in the database.
+func (r *roleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config roleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.AuthoritativeMemberships.IsUnknown() || config.MemberOf.IsUnknown() {
+		return
+	}
+	authoritative := config.AuthoritativeMemberships.ValueBool()
+	switch {
+	case authoritative && config.MemberOf.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("member_of"), "Missing Memberships",
+			"member_of must be set when authoritative_memberships is true. Set it to an empty list to revoke every membership of the role.")
+	case !authoritative && !config.MemberOf.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("member_of"), "Memberships Are Not Managed",
+			"member_of can only be set when authoritative_memberships is true. Otherwise it is read from the database.")
+	}
+}
+
+// ModifyPlan refuses changes that would drop the login or superuser status of the role the
+// provider is authenticated as, revoke the memberships its authority comes from, or drop that
+// role altogether. The rest of the apply would then fail with authorization errors that do not
+// point at the cause. A role being updated is also checked for drift.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create, or before the provider is configured.
+	if req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired *scylladb.Role
+	if !req.Plan.Raw.IsNull() {
+		var plan roleResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.CanLogin.IsUnknown() || plan.IsSuperuser.IsUnknown() {
+			return
+		}
+		role := planToRole(plan)
+		if !plan.MemberOf.IsUnknown() {
+			var diags diag.Diagnostics
+			role.MemberOf, diags = authoritativeMemberOf(ctx, plan)
+			resp.Diagnostics.Append(diags...)
+		}
+		desired = &role
+	}
+
+	current := planToRole(state)
+	if !state.MemberOf.IsNull() && !state.MemberOf.IsUnknown() {
+		current.MemberOf = []string{}
+		resp.Diagnostics.Append(state.MemberOf.ElementsAs(ctx, &current.MemberOf, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.clusterFor(ctx, state).CheckSessionRoleChange(current, desired)
+	switch {
+	case errors.Is(err, scylladb.ErrSessionRoleLockout):
+		resp.Diagnostics.AddError("Change Would Lock Out the Provider",
+			err.Error()+". Configure the provider with a separate administrative role to change this role.")
+	case err != nil:
+		resp.Diagnostics.AddWarning("Unable to Check the Provider Role",
+			"Could not check whether the change keeps the authority of the role the provider is authenticated as: "+err.Error())
+	}
+	if desired != nil {
+		r.warnRoleDrift(ctx, state, resp)
+	}
+}
+
+// warnRoleDrift warns about each of can_login, is_superuser, and member_of that changed in the
+// database since the state was refreshed, e.g. when planning with -refresh=false. Read refreshes
+// them, so a plan with refresh already shows the drift, but without it the plan compares the
+// configuration against stale state and may miss a change to correct. Unlike a grant, which is
+// replaced, the role is only updated in place, and the attributes are configured, so the plan
+// cannot be changed to include the correction. It never blocks the plan: when the role cannot be
+// read, nothing is reported.
+func (r *roleResource) warnRoleDrift(ctx context.Context, state roleResourceModel, resp *resource.ModifyPlanResponse) {
+	live, err := r.clusterFor(ctx, state).GetRole(state.ID.ValueString())
+	if err != nil {
+		return
+	}
+	drifted, diags := roleDrift(ctx, state, live)
+	if diags.HasError() {
+		return
+	}
+	for _, attribute := range drifted {
+		resp.Diagnostics.AddAttributeWarning(path.Root(attribute), "Role Changed Outside Terraform",
+			fmt.Sprintf("The %s of role %q changed in the database since the state was refreshed. "+
+				"Plan with refresh enabled to see whether the change is corrected.", attribute, live.Role))
+	}
+}
+
+// roleDrift returns the names of the attributes of state that differ from the role in the
+// database. member_of is compared as a set, and only when it is known.
+func roleDrift(ctx context.Context, state roleResourceModel, live scylladb.Role) ([]string, diag.Diagnostics) {
+	var drifted []string
+	if !state.CanLogin.IsNull() && state.CanLogin.ValueBool() != live.CanLogin {
+		drifted = append(drifted, "can_login")
+	}
+	if !state.IsSuperuser.IsNull() && state.IsSuperuser.ValueBool() != live.IsSuperuser {
+		drifted = append(drifted, "is_superuser")
+	}
+	if state.MemberOf.IsNull() || state.MemberOf.IsUnknown() {
+		return drifted, nil
+	}
+	memberOf, diags := memberOfValue(ctx, state.MemberOf, live.MemberOf)
+	if !diags.HasError() && !memberOf.Equal(state.MemberOf) {
+		drifted = append(drifted, "member_of")
+	}
+	return drifted, diags
+}
+
 // The provider uses the `Create` method to create a new resource based on the schemadata.
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -114,20 +298,36 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Get role from plan
 	role := planToRole(plan)
+	password, diags := rolePassword(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	role.Password = password
+	role.MemberOf, diags = authoritativeMemberOf(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Create a role
-	err := r.client.CreateRole(role)
+	// Create a role, granting it the roles of an authoritative member_of
+	client := r.clusterFor(ctx, plan)
+	err := client.CreateRole(role)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create the role",
 			err.Error(),
 		)
+		if errors.Is(err, scylladb.ErrRoleMembershipsIncomplete) {
+			r.savePartialRole(ctx, client, plan, resp)
+		}
 		return
 	}
 
 	// Populate computed attribute values
 	plan.ID = types.StringValue(role.Role)
-	memberOf, diags := types.ListValueFrom(ctx, types.StringType, []string{})
+	plan.Created = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	memberOf, diags := r.readMemberOf(ctx, client, role.Role, plan.MemberOf)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -141,6 +341,23 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 }
 
+// savePartialRole saves the role Create made before granting one of its memberships failed in the
+// state, so that it is not left in the cluster untracked, where the next apply would fail because
+// the role already exists. Terraform taints the resource, so the next apply replaces it. member_of
+// holds the memberships that were granted. A warning is added when the role cannot be read.
+func (r *roleResource) savePartialRole(ctx context.Context, client *scylladb.Cluster, plan roleResourceModel, resp *resource.CreateResponse) {
+	memberOf, diags := r.readMemberOf(ctx, client, plan.Role.ValueString(), types.ListNull(types.StringType))
+	if diags.HasError() {
+		resp.Diagnostics.AddWarning("Role Not Saved in State",
+			fmt.Sprintf("The role %s may exist without being tracked. Import it with terraform import or drop it before applying again.", plan.Role.ValueString()))
+		return
+	}
+	plan.ID = types.StringValue(plan.Role.ValueString())
+	plan.Created = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	plan.MemberOf = memberOf
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
 // The provider uses the `Read` method to retrieve the resource's information and update the state
 // The provider invokes this function before every plan.
 func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -152,7 +369,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	curRole, err := r.client.GetRole(state.ID.ValueString())
+	curRole, err := r.clusterFor(ctx, state).GetRole(state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, scylladb.ErrRoleNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -165,7 +382,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	memberOf, diags := types.ListValueFrom(ctx, types.StringType, curRole.MemberOf)
+	memberOf, diags := memberOfValue(ctx, state.MemberOf, curRole.MemberOf)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -174,10 +391,20 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Overwrite with refreshed state.
 	state = roleResourceModel{
 		ID:          types.StringValue(curRole.Role),
+		Created:     state.Created,
 		Role:        types.StringValue(curRole.Role),
 		CanLogin:    types.BoolValue(curRole.CanLogin),
 		IsSuperuser: types.BoolValue(curRole.IsSuperuser),
 		MemberOf:    memberOf,
+
+		// The password cannot be read back, so keep whatever was last applied
+		Password:    state.Password,
+		PasswordEnv: state.PasswordEnv,
+
+		// Imported roles are not authoritative until configured otherwise
+		AuthoritativeMemberships: types.BoolValue(state.AuthoritativeMemberships.ValueBool()),
+
+		SystemAuthKeyspace: state.SystemAuthKeyspace,
 	}
 
 	// Set state.
@@ -190,17 +417,38 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // The provider uses the `Update` method to update an existing resource based on the schema data.
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan roleResourceModel
+	var plan, state roleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Get role from plan
 	role := planToRole(plan)
+	parents, diags := authoritativeMemberOf(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	role.MemberOf = parents
+
+	// The password is only set when its configuration changed, since it cannot be compared
+	// with the one in the database.
+	if !plan.Password.Equal(state.Password) || !plan.PasswordEnv.Equal(state.PasswordEnv) {
+		password, diags := rolePassword(plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		role.Password = password
+	}
 
-	// Update the role
-	err := r.client.UpdateRole(role)
+	// Update the role. The ALTER is skipped when the role already matches, e.g. when it was
+	// changed to the planned values outside of Terraform, and only the memberships that differ
+	// from an authoritative member_of are granted or revoked.
+	client := r.clusterFor(ctx, plan)
+	altered, err := client.UpdateRoleIfChanged(role)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update the role",
@@ -208,17 +456,22 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+	if !altered {
+		tflog.Debug(ctx, "Role already matches the plan, skipping ALTER ROLE", map[string]any{"role": role.Role})
+	}
 
-	// member_of is computed and not affected by this update; preserve from state.
-	var state roleResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	// member_of is computed unless authoritative; read it back so state matches the database.
+	memberOf, diags := r.readMemberOf(ctx, client, role.Role, plan.MemberOf)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	plan.MemberOf = state.MemberOf
+	plan.MemberOf = memberOf
 
-	// Populate computed attribute values
+	// Populate computed attribute values. The creation time is kept, and stays null for an
+	// imported role, which UseStateForUnknown leaves unknown in the plan.
 	plan.ID = types.StringValue(role.Role)
+	plan.Created = state.Created
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -229,6 +482,11 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // The provider uses the `Delete` method to attempt to retrieve the values from state and delete the resource.
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state roleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -242,7 +500,7 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete the role
-	err := r.client.DeleteRole(role)
+	err := r.clusterFor(ctx, state).DeleteRole(role)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete the role",
@@ -257,6 +515,76 @@ func (r *roleResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// authoritativeMemberOf returns the roles of member_of when authoritative_memberships is set, as
+// the MemberOf of the role to create or update, and nil otherwise so memberships are left alone.
+func authoritativeMemberOf(ctx context.Context, plan roleResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.AuthoritativeMemberships.ValueBool() {
+		return nil, diags
+	}
+	parents := []string{}
+	diags.Append(plan.MemberOf.ElementsAs(ctx, &parents, false)...)
+	return parents, diags
+}
+
+// readMemberOf returns the roles the role is a member of, as stored in the database, in the
+// order of prior when it lists the same roles.
+func (r *roleResource) readMemberOf(ctx context.Context, client *scylladb.Cluster, roleName string, prior types.List) (types.List, diag.Diagnostics) {
+	curRole, err := client.GetRole(roleName)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Unable to read the role",
+			err.Error(),
+		)
+		return types.ListNull(types.StringType), diags
+	}
+	return memberOfValue(ctx, prior, curRole.MemberOf)
+}
+
+// clusterFor returns the client of the provider bound to the context of the operation, reading
+// from the system_auth_keyspace of model when it is set.
+func (r *roleResource) clusterFor(ctx context.Context, model roleResourceModel) *scylladb.Cluster {
+	return r.client.WithSystemAuthKeyspace(model.SystemAuthKeyspace.ValueString()).WithContext(ctx)
+}
+
+// memberOfValue converts the memberships read from the database to a list. The database returns
+// them sorted, so the configured order of prior is kept when it lists the same roles, avoiding a
+// permanent difference for an unsorted member_of.
+func memberOfValue(ctx context.Context, prior types.List, memberOf []string) (types.List, diag.Diagnostics) {
+	if memberOf == nil {
+		memberOf = []string{}
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorMemberOf []string
+		diags := prior.ElementsAs(ctx, &priorMemberOf, false)
+		if !diags.HasError() && len(priorMemberOf) == len(memberOf) && !slices.ContainsFunc(priorMemberOf, func(parent string) bool {
+			return !slices.Contains(memberOf, parent)
+		}) {
+			return prior, diags
+		}
+	}
+	return types.ListValueFrom(ctx, types.StringType, memberOf)
+}
+
+// rolePassword returns the password to set for the role: password, or else the value of the
+// environment variable named by password_env. It is empty when neither is set.
+func rolePassword(plan roleResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.Password.IsNull() {
+		return plan.Password.ValueString(), diags
+	}
+	if plan.PasswordEnv.IsNull() {
+		return "", diags
+	}
+	password := os.Getenv(plan.PasswordEnv.ValueString())
+	if password == "" {
+		diags.AddAttributeError(path.Root("password_env"), "Missing Password",
+			fmt.Sprintf("The environment variable %s named by password_env is not set or empty.", plan.PasswordEnv.ValueString()))
+	}
+	return password, diags
+}
+
 func planToRole(plan roleResourceModel) scylladb.Role {
 	return scylladb.Role{
 		Role:        plan.Role.ValueString(),
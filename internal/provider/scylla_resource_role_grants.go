@@ -0,0 +1,299 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+var _ resource.Resource = &roleGrantsResource{}
+var _ resource.ResourceWithConfigure = &roleGrantsResource{}
+var _ resource.ResourceWithImportState = &roleGrantsResource{}
+
+func NewRoleGrantsResource() resource.Resource {
+	return &roleGrantsResource{}
+}
+
+type roleGrantsResource struct {
+	client *scylladb.Cluster
+}
+
+type roleGrantsResourceModel struct {
+	ID     types.String     `tfsdk:"id"`
+	Role   types.String     `tfsdk:"role"`
+	Grants []roleGrantModel `tfsdk:"grant"`
+}
+
+// roleGrantModel is a set of privileges on a single data resource.
+type roleGrantModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	Keyspace     types.String `tfsdk:"keyspace"`
+	Identifier   types.String `tfsdk:"identifier"`
+	Privileges   types.Set    `tfsdk:"privileges"`
+}
+
+func (r *roleGrantsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_grants"
+}
+
+func (r *roleGrantsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages all grants of a role on keyspaces and tables. Any existing grants of the role not specified in `grant` blocks are revoked on apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The role name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role to manage grants for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"grant": schema.SetNestedBlock{
+				Description: "Privileges to grant to the role on a resource. Each resource may only appear in one block.",
+				Validators: []validator.Set{
+					uniqueRoleGrantResourcesValidator{},
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Description: "The type of resource, in uppercase (ALL KEYSPACES, KEYSPACE, or TABLE).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("ALL KEYSPACES", "KEYSPACE", "TABLE"),
+							},
+						},
+						"keyspace": schema.StringAttribute{
+							Description: "The keyspace of the resource. Required unless resource_type is ALL KEYSPACES.",
+							Optional:    true,
+						},
+						"identifier": schema.StringAttribute{
+							Description: "The table name. Required when resource_type is TABLE.",
+							Optional:    true,
+						},
+						"privileges": schema.SetAttribute{
+							Description: "Privileges to grant, in uppercase as they are read back (e.g. ALTER, SELECT, MODIFY).",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(
+									stringvalidator.OneOf(scylladb.ValidPrivileges...),
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *roleGrantsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *roleGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleGrantsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPlanData(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleGrantsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grants, diags := r.readGrants(ctx, state.Role.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Grants = grants
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleGrantsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPlanData(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state roleGrantsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Converging to an empty set revokes every data grant of the role
+	_, removed, err := r.client.WithContext(ctx).ReconcileGrants(state.Role.ValueString(), nil)
+	tflog.Debug(ctx, fmt.Sprintf("Revoked grants: %v", removed))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Revoking Role Grants", err.Error())
+		return
+	}
+}
+
+func (r *roleGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	grants, diags := r.readGrants(ctx, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := roleGrantsResourceModel{
+		ID:     types.StringValue(req.ID),
+		Role:   types.StringValue(req.ID),
+		Grants: grants,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleGrantsResource) applyPlanData(ctx context.Context, plan *roleGrantsResourceModel) (diags diag.Diagnostics) {
+	desired := make([]scylladb.Grant, 0, len(plan.Grants))
+	for i, g := range plan.Grants {
+		resourceType := g.ResourceType.ValueString()
+		grantPath := path.Root("grant")
+		switch {
+		case resourceType != "ALL KEYSPACES" && g.Keyspace.ValueString() == "":
+			diags.AddAttributeError(grantPath, "Missing Keyspace",
+				fmt.Sprintf("Grant %d on a %s must set keyspace.", i+1, resourceType))
+			continue
+		case resourceType == "TABLE" && g.Identifier.ValueString() == "":
+			diags.AddAttributeError(grantPath, "Missing Identifier",
+				fmt.Sprintf("Grant %d on a TABLE must set identifier to the table name.", i+1))
+			continue
+		}
+
+		var privs []string
+		diags.Append(g.Privileges.ElementsAs(ctx, &privs, false)...)
+		for _, priv := range privs {
+			desired = append(desired, scylladb.Grant{
+				Privilege:    priv,
+				ResourceType: resourceType,
+				Keyspace:     g.Keyspace.ValueString(),
+				Identifier:   g.Identifier.ValueString(),
+			})
+		}
+	}
+	if diags.HasError() {
+		return
+	}
+
+	added, removed, err := r.client.WithContext(ctx).ReconcileGrants(plan.Role.ValueString(), desired)
+	tflog.Debug(ctx, fmt.Sprintf("Reconciled grants: added = %v | removed = %v", added, removed))
+	if err != nil {
+		diags.AddError("Error Applying Role Grants", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Role.ValueString())
+	return
+}
+
+// readGrants returns the role's data grants grouped by resource.
+func (r *roleGrantsResource) readGrants(ctx context.Context, roleName string) (grants []roleGrantModel, diags diag.Diagnostics) {
+	current, err := r.client.WithContext(ctx).ListAllGrants(roleName)
+	if err != nil {
+		diags.AddError("Error Reading Role Grants", err.Error())
+		return
+	}
+
+	// ListAllGrants is sorted by resource, so grants on the same resource are adjacent
+	var resources []scylladb.Grant
+	privileges := make(map[scylladb.Grant][]string)
+	for _, grant := range current {
+		if !slices.Contains([]string{"ALL KEYSPACES", "KEYSPACE", "TABLE"}, strings.ToUpper(grant.ResourceType)) {
+			continue
+		}
+		resource := scylladb.Grant{ResourceType: strings.ToUpper(grant.ResourceType), Keyspace: grant.Keyspace, Identifier: grant.Identifier}
+		if _, ok := privileges[resource]; !ok {
+			resources = append(resources, resource)
+		}
+		privileges[resource] = append(privileges[resource], strings.ToUpper(grant.Privilege))
+	}
+
+	grants = []roleGrantModel{}
+	for _, resource := range resources {
+		privSet, setDiags := types.SetValueFrom(ctx, types.StringType, privileges[resource])
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		grants = append(grants, roleGrantModel{
+			ResourceType: types.StringValue(resource.ResourceType),
+			Keyspace:     optionalString(resource.Keyspace),
+			Identifier:   optionalString(resource.Identifier),
+			Privileges:   privSet,
+		})
+	}
+	return grants, diags
+}
+
+// optionalString maps an empty string to null, matching an unset optional attribute.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,164 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccRoleGrantsResource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+	setupTestKeyspaceAndTable(t, []string{devClusterHost})
+
+	config := providerConfig + `
+resource "scylladb_role" "analyst" {
+  role      = "analyst"
+  can_login = false
+}
+resource "scylladb_role_grants" "analyst" {
+  role = scylladb_role.analyst.role
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["SELECT"]
+  }
+  grant {
+    resource_type = "TABLE"
+    keyspace      = "cycling"
+    identifier    = "cyclist_name"
+    privileges    = ["MODIFY", "SELECT"]
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_role_grants.analyst", "id", "analyst"),
+					resource.TestCheckResourceAttr("scylladb_role_grants.analyst", "grant.#", "2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scylladb_role_grants.analyst",
+				ImportState:       true,
+				ImportStateId:     "analyst",
+				ImportStateVerify: true,
+			},
+			// Externally add a grant, verify it is revoked on apply
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster client: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.CreateGrant(scylladb.Grant{
+						RoleName:     "analyst",
+						Privilege:    "ALTER",
+						ResourceType: "KEYSPACE",
+						Keyspace:     "cycling",
+					}); err != nil {
+						t.Fatalf("failed to add external grant: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_role_grants.analyst", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						return fmt.Errorf("failed to create cluster client: %w", err)
+					}
+					defer cluster.Session.Close()
+					grants, err := cluster.ListAllGrants("analyst")
+					if err != nil {
+						return fmt.Errorf("failed to list grants: %w", err)
+					}
+					if len(grants) != 3 {
+						return fmt.Errorf("expected 3 grants for analyst, got %d: %v", len(grants), grants)
+					}
+					for _, grant := range grants {
+						if grant.Privilege == "ALTER" {
+							return fmt.Errorf("expected the external ALTER grant to be revoked, got %v", grants)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRoleGrantsResourceDuplicateResource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "scylladb_role_grants" "analyst" {
+  role = "analyst"
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["SELECT"]
+  }
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["MODIFY"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Duplicate Grant Resource`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+// TestAccRoleGrantsResourceLowercasePrivilege verifies that privileges must be uppercase, since
+// they are read back in uppercase and a lowercase one would show a diff on every plan.
+func TestAccRoleGrantsResourceLowercasePrivilege(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "scylladb_role_grants" "analyst" {
+  role = "analyst"
+  grant {
+    resource_type = "KEYSPACE"
+    keyspace      = "cycling"
+    privileges    = ["select"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
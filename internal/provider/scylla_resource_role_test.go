@@ -3,11 +3,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
 	"github.com/retailnext/terraform-provider-scylladb/scylladb"
 )
@@ -76,10 +88,41 @@ resource "scylladb_role" "admin" {
 	})
 }
 
+// TestAccRoleResourceSessionRoleLockout verifies that a plan taking away the login of the role
+// the provider is authenticated as is refused before anything is applied.
+func TestAccRoleResourceSessionRoleLockout(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+import {
+  to = scylladb_role.self
+  id = "cassandra"
+}
+
+resource "scylladb_role" "self" {
+    role = "cassandra"
+    can_login = false
+    is_superuser = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Change Would Lock Out the Provider`),
+			},
+		},
+	})
+}
+
 func TestAccRoleResource(t *testing.T) {
 	devClusterHost := testutil.NewTestContainer(t)
 	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
 
+	// The creation time is set once and kept across updates
+	sameCreated := statecheck.CompareValue(compare.ValuesSame())
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -98,13 +141,18 @@ resource "scylladb_role" "admin" {
 					resource.TestCheckResourceAttr("scylladb_role.admin", "is_superuser", "false"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("scylladb_role.admin", "id"),
+					resource.TestMatchResourceAttr("scylladb_role.admin", "created", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameCreated.AddStateValue("scylladb_role.admin", tfjsonpath.New("created")),
+				},
 			},
-			// ImportState testing
+			// ImportState testing. The creation time of an imported role is unknown.
 			{
-				ResourceName:      "scylladb_role.admin",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "scylladb_role.admin",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created"},
 			},
 			// Update and Read testing
 			{
@@ -120,8 +168,321 @@ resource "scylladb_role" "admin" {
 					resource.TestCheckResourceAttr("scylladb_role.admin", "can_login", "true"),
 					resource.TestCheckResourceAttr("scylladb_role.admin", "is_superuser", "false"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					sameCreated.AddStateValue("scylladb_role.admin", tfjsonpath.New("created")),
+				},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+// TestAccRoleResourceMemberOf verifies that member_of in state is read from the database after
+// an apply rather than carried over from the plan or a previous state.
+func TestAccRoleResourceMemberOf(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	roleConfigFmt := providerConfig + `
+resource "scylladb_role" "parent" {
+    role = "parent"
+}
+resource "scylladb_role" "child" {
+    role = "child"
+    can_login = %t
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(roleConfigFmt, false),
+				Check:  resource.TestCheckResourceAttr("scylladb_role.child", "member_of.#", "0"),
+			},
+			// Grant the membership outside of Terraform along with an update
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster config: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.Session.Query(`GRANT parent TO child`).Exec(); err != nil {
+						t.Fatalf("failed to grant role membership: %s", err)
+					}
+				},
+				Config: fmt.Sprintf(roleConfigFmt, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_role.child", "can_login", "true"),
+					func(s *terraform.State) error {
+						cluster, err := getTestScyllaClient([]string{devClusterHost})
+						if err != nil {
+							return fmt.Errorf("failed to create cluster config: %w", err)
+						}
+						defer cluster.Session.Close()
+						role, err := cluster.GetRole("child")
+						if err != nil {
+							return fmt.Errorf("failed to get role: %w", err)
+						}
+						attrs := s.RootModule().Resources["scylladb_role.child"].Primary.Attributes
+						if attrs["member_of.#"] != fmt.Sprint(len(role.MemberOf)) {
+							return fmt.Errorf("expected member_of %v in state, got %s entries", role.MemberOf, attrs["member_of.#"])
+						}
+						for i, memberOf := range role.MemberOf {
+							if got := attrs[fmt.Sprintf("member_of.%d", i)]; got != memberOf {
+								return fmt.Errorf("expected member_of.%d to be %q, got %q", i, memberOf, got)
+							}
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("scylladb_role.child", "member_of.0", "parent"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccRoleResourceAuthoritativeMemberships verifies that memberships granted outside of
+// Terraform are revoked on apply when authoritative_memberships is set.
+func TestAccRoleResourceAuthoritativeMemberships(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_role" "readers" {
+    role = "readers"
+}
+resource "scylladb_role" "writers" {
+    role = "writers"
+}
+resource "scylladb_role" "analyst" {
+    role                      = "analyst"
+    member_of                 = [scylladb_role.readers.role]
+    authoritative_memberships = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_role.analyst", "member_of.#", "1"),
+					resource.TestCheckResourceAttr("scylladb_role.analyst", "member_of.0", "readers"),
+				),
+			},
+			// Grant a membership outside of Terraform, verify it is revoked on apply
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster config: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.Session.Query(`GRANT writers TO analyst`).Exec(); err != nil {
+						t.Fatalf("failed to grant role membership: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_role.analyst", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_role.analyst", "member_of.#", "1"),
+					func(_ *terraform.State) error {
+						cluster, err := getTestScyllaClient([]string{devClusterHost})
+						if err != nil {
+							return fmt.Errorf("failed to create cluster config: %w", err)
+						}
+						defer cluster.Session.Close()
+						role, err := cluster.GetRole("analyst")
+						if err != nil {
+							return fmt.Errorf("failed to get role: %w", err)
+						}
+						if !slices.Equal(role.MemberOf, []string{"readers"}) {
+							return fmt.Errorf("expected analyst to only be a member of readers, got %v", role.MemberOf)
+						}
+						return nil
+					},
+				),
+			},
+			// member_of cannot be set without authoritative_memberships
+			{
+				Config: providerConfig + `
+resource "scylladb_role" "analyst" {
+    role      = "analyst"
+    member_of = ["readers"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Memberships Are Not Managed`),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+// TestAccRoleResourcePassword verifies that the password is set on create and changed in place,
+// both from password and from the environment variable named by password_env.
+func TestAccRoleResourcePassword(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+	t.Setenv("TEST_APP_PASSWORD", "from_env")
+
+	checkLogin := func(password string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			cluster, err := getTestScyllaClient([]string{devClusterHost})
+			if err != nil {
+				return fmt.Errorf("failed to create cluster client: %w", err)
+			}
+			defer cluster.Session.Close()
+			return cluster.VerifyLogin("app", password)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "scylladb_role" "app" {
+  role      = "app"
+  can_login = true
+  password  = "first_password"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_role.app", "password", "first_password"),
+					checkLogin("first_password"),
+				),
+			},
+			// Changing the password updates the role in place
+			{
+				Config: providerConfig + `
+resource "scylladb_role" "app" {
+  role      = "app"
+  can_login = true
+  password  = "second_password"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_role.app", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: checkLogin("second_password"),
+			},
+			// Switching to password_env reads the password from the environment
+			{
+				Config: providerConfig + `
+resource "scylladb_role" "app" {
+  role         = "app"
+  can_login    = true
+  password_env = "TEST_APP_PASSWORD"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("scylladb_role.app", "password"),
+					checkLogin("from_env"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "scylladb_role" "app" {
+  role         = "app"
+  password     = "pw"
+  password_env = "TEST_APP_PASSWORD"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestRoleDrift(t *testing.T) {
+	ctx := context.Background()
+	state := roleResourceModel{
+		CanLogin:    types.BoolValue(true),
+		IsSuperuser: types.BoolValue(false),
+		MemberOf:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+	}
+	for _, tc := range []struct {
+		name string
+		live scylladb.Role
+		want []string
+	}{
+		{"unchanged", scylladb.Role{CanLogin: true, MemberOf: []string{"b", "a"}}, nil},
+		{"login", scylladb.Role{MemberOf: []string{"a", "b"}}, []string{"can_login"}},
+		{"superuser", scylladb.Role{CanLogin: true, IsSuperuser: true, MemberOf: []string{"a", "b"}}, []string{"is_superuser"}},
+		{"membership", scylladb.Role{CanLogin: true, MemberOf: []string{"a"}}, []string{"member_of"}},
+		{"all", scylladb.Role{IsSuperuser: true}, []string{"can_login", "is_superuser", "member_of"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			drifted, diags := roleDrift(ctx, state, tc.live)
+			if diags.HasError() {
+				t.Fatalf("roleDrift: %v", diags)
+			}
+			if !slices.Equal(drifted, tc.want) {
+				t.Errorf("roleDrift = %v, want %v", drifted, tc.want)
+			}
+		})
+	}
+
+	state.MemberOf = types.ListUnknown(types.StringType)
+	if drifted, _ := roleDrift(ctx, state, scylladb.Role{CanLogin: true}); len(drifted) != 0 {
+		t.Errorf("roleDrift with unknown member_of = %v, want none", drifted)
+	}
+}
+
+// TestRoleResourceCreateMissingParent verifies that when a membership of a new role cannot be
+// granted, the created role is saved in the state rather than left untracked.
+func TestRoleResourceCreateMissingParent(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+
+	ctx := context.Background()
+	r := &roleResource{client: cluster}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &roleResourceModel{
+		ID:                       types.StringUnknown(),
+		Created:                  types.StringUnknown(),
+		Role:                     types.StringValue("orphan"),
+		CanLogin:                 types.BoolValue(false),
+		IsSuperuser:              types.BoolValue(false),
+		MemberOf:                 types.ListValueMust(types.StringType, []attr.Value{types.StringValue("no_such_parent")}),
+		Password:                 types.StringNull(),
+		PasswordEnv:              types.StringNull(),
+		AuthoritativeMemberships: types.BoolValue(true),
+		SystemAuthKeyspace:       types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected granting a missing parent role to fail")
+	}
+
+	var state roleResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("failed to get state: %v", diags)
+	}
+	if state.ID.ValueString() != "orphan" {
+		t.Fatalf("expected role orphan in state, got %q", state.ID.ValueString())
+	}
+	if len(state.MemberOf.Elements()) != 0 {
+		t.Errorf("expected no memberships in state, got %v", state.MemberOf)
+	}
+}
@@ -0,0 +1,703 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+var _ resource.Resource = &schemaResource{}
+var _ resource.ResourceWithConfigure = &schemaResource{}
+var _ resource.ResourceWithImportState = &schemaResource{}
+var _ resource.ResourceWithValidateConfig = &schemaResource{}
+var _ resource.ResourceWithModifyPlan = &schemaResource{}
+
+// cqlIdentifier matches the unquoted names the schema resource accepts for keyspaces, tables,
+// and columns. Unquoted names are stored in lower case, so only lower case is accepted to keep
+// the configuration and the stored schema comparable.
+var cqlIdentifier = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func NewSchemaResource() resource.Resource {
+	return &schemaResource{}
+}
+
+type schemaResource struct {
+	client *scylladb.Cluster
+}
+
+type schemaResourceModel struct {
+	ID                types.String       `tfsdk:"id"`
+	Keyspace          types.String       `tfsdk:"keyspace"`
+	ReplicationClass  types.String       `tfsdk:"replication_class"`
+	ReplicationFactor types.Int64        `tfsdk:"replication_factor"`
+	Datacenters       types.Map          `tfsdk:"datacenters"`
+	DurableWrites     types.Bool         `tfsdk:"durable_writes"`
+	WaitForRepair     types.Bool         `tfsdk:"wait_for_repair"`
+	Tables            []schemaTableModel `tfsdk:"table"`
+}
+
+type schemaTableModel struct {
+	Name            types.String `tfsdk:"name"`
+	Columns         types.Map    `tfsdk:"columns"`
+	PartitionKey    types.List   `tfsdk:"partition_key"`
+	ClusteringKey   types.List   `tfsdk:"clustering_key"`
+	ClusteringOrder types.Map    `tfsdk:"clustering_order"`
+	Properties      types.Map    `tfsdk:"properties"`
+}
+
+// unknown reports whether any attribute the table definition is derived from is unknown.
+func (m schemaTableModel) unknown() bool {
+	return m.Name.IsUnknown() || m.Columns.IsUnknown() || m.PartitionKey.IsUnknown() || m.ClusteringKey.IsUnknown() ||
+		m.ClusteringOrder.IsUnknown() || m.Properties.IsUnknown()
+}
+
+func (r *schemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (r *schemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	identifierValidators := []validator.String{
+		stringvalidator.RegexMatches(cqlIdentifier, "must be a lower case unquoted CQL identifier"),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages a keyspace and all of its tables. The keyspace is created first and the tables after it, " +
+			"waiting for the nodes to agree on the schema in between; they are dropped in the reverse order. " +
+			"Any existing table of the keyspace not specified in `table` blocks is dropped on apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The keyspace name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keyspace": schema.StringAttribute{
+				Description: "The name of the keyspace.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: identifierValidators,
+			},
+			"replication_class": schema.StringAttribute{
+				Description: "The replication strategy of the keyspace (SimpleStrategy or NetworkTopologyStrategy). Defaults to SimpleStrategy.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SimpleStrategy"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("SimpleStrategy", scylladb.NetworkTopologyStrategy),
+				},
+			},
+			"replication_factor": schema.Int64Attribute{
+				Description: "The replication factor. Required with SimpleStrategy.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"datacenters": schema.MapAttribute{
+				Description: "The replication factor of each datacenter. Required with NetworkTopologyStrategy.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"durable_writes": schema.BoolAttribute{
+				Description: "Whether writes to the keyspace go through the commit log. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"wait_for_repair": schema.BoolAttribute{
+				Description: "Whether an apply that alters the replication waits, within ddl_timeout, until the nodes agree on the schema " +
+					"and report the new replication before completing. Repair cannot be run through CQL, so run it separately " +
+					"before relying on the new replicas. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"table": schema.SetNestedBlock{
+				Description: "A table of the keyspace. Only regular columns can be added or removed and properties changed in place; " +
+					"changing the primary key, the clustering order, or the type of a column requires dropping the table from the configuration first.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the table.",
+							Required:    true,
+							Validators:  identifierValidators,
+						},
+						"columns": schema.MapAttribute{
+							Description: "The CQL type of each column, including the primary key columns. " +
+								"Use the canonical type names ScyllaDB reports (e.g. text rather than varchar) to avoid spurious differences.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+						"partition_key": schema.ListAttribute{
+							Description: "The partition key columns, in order.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"clustering_key": schema.ListAttribute{
+							Description: "The clustering columns, in order.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"clustering_order": schema.MapAttribute{
+							Description: "The sort order, ASC or DESC, of every clustering column. " +
+								"Only set it when a clustering column sorts descending; clustering columns sort ascending by default.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.ValueStringsAre(stringvalidator.OneOf("ASC", "DESC")),
+							},
+						},
+						"properties": schema.MapAttribute{
+							Description: "Table properties to manage, by name: comment, compaction (the name of the compaction strategy class, " +
+								"e.g. LeveledCompactionStrategy), default_time_to_live, and gc_grace_seconds (both in seconds). " +
+								"They are changed in place, and properties not listed are left as they are.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.KeysAre(stringvalidator.OneOf(scylladb.TableProperties()...)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *schemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*scylladb.Cluster)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *scylladb.Cluster, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig checks that the replication settings match the replication class and that the
+// primary key columns, clustering order, and properties of each table are valid.
+func (r *schemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config schemaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateReplicationConfig(config.ReplicationClass, config.ReplicationFactor, config.Datacenters)...)
+
+	for _, t := range config.Tables {
+		if t.unknown() {
+			continue
+		}
+		table, diags := tableFromModel(ctx, config.Keyspace.ValueString(), t)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		if err := table.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("table"), "Invalid Table Definition", err.Error())
+			continue
+		}
+		// Read reports the order of every clustering column when one sorts descending and
+		// none otherwise, so anything else would never match the stored schema.
+		if !t.ClusteringOrder.IsNull() && (len(table.ClusteringOrder) != len(table.ClusteringKey) || len(table.ClusteringOrder) == 0 ||
+			!slices.ContainsFunc(table.ClusteringOrder, func(order scylladb.ClusteringOrder) bool { return order.Desc })) {
+			resp.Diagnostics.AddAttributeError(path.Root("table"), "Invalid Clustering Order",
+				fmt.Sprintf("The clustering_order of table %s must set the order of every clustering column, and is only needed when one of them sorts DESC.", table.Name))
+		}
+	}
+}
+
+// ModifyPlan refuses changes that cannot be applied to an existing table in place, rather than
+// failing halfway through the apply.
+func (r *schemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state, plan schemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := tablesFromModel(ctx, state.Keyspace.ValueString(), state.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, t := range plan.Tables {
+		if t.unknown() {
+			continue
+		}
+		existing, ok := current[t.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		desired, diags := tableFromModel(ctx, plan.Keyspace.ValueString(), t)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		if err := scylladb.ValidateTableChange(existing, desired); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("table"), "Unsupported Table Change", err.Error())
+		}
+	}
+}
+
+func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+	var plan schemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ks, diags := keyspaceFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tables, diags := tablesFromModel(ctx, ks.Name, plan.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := client.CheckReplicationDatacenters(ks, true); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("datacenters"), "Error Creating Keyspace", err.Error())
+		return
+	}
+	if err := client.CreateKeyspaceStrict(ks); err != nil {
+		if errors.Is(err, scylladb.ErrKeyspaceAlreadyExists) {
+			resp.Diagnostics.AddError("Keyspace Already Exists",
+				fmt.Sprintf("The keyspace %s already exists. Import it with terraform import to manage it with this resource.", ks.Name))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Keyspace", err.Error())
+		return
+	}
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Creating Keyspace", err.Error())
+		r.savePartialSchema(ctx, plan, resp)
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(tables)) {
+		if err := client.CreateTable(tables[name]); err != nil {
+			resp.Diagnostics.AddError("Error Creating Table", fmt.Sprintf("Failed to create table %s.%s: %s", ks.Name, name, err))
+			r.savePartialSchema(ctx, plan, resp)
+			return
+		}
+	}
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Creating Table", err.Error())
+		r.savePartialSchema(ctx, plan, resp)
+		return
+	}
+
+	state, diags := r.readSchema(ctx, ks.Name, plan.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.WaitForRepair = plan.WaitForRepair
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// savePartialSchema saves the keyspace and the tables Create made before failing in the state, so
+// that they are not left in the cluster untracked, where the next apply would fail because the
+// keyspace already exists. Terraform taints the resource, so the next apply replaces it. Nothing
+// is saved when the keyspace does not exist, and a warning is added when it cannot be read.
+func (r *schemaResource) savePartialSchema(ctx context.Context, plan schemaResourceModel, resp *resource.CreateResponse) {
+	state, diags := r.readSchema(ctx, plan.Keyspace.ValueString(), plan.Tables)
+	if state == nil && !diags.HasError() {
+		return
+	}
+	if diags.HasError() {
+		resp.Diagnostics.AddWarning("Keyspace Not Saved in State",
+			fmt.Sprintf("The keyspace %s may exist without being tracked. Import it with terraform import or drop it before applying again.", plan.Keyspace.ValueString()))
+		return
+	}
+	state.WaitForRepair = plan.WaitForRepair
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := r.readSchema(ctx, state.Keyspace.ValueString(), state.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if current == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !state.WaitForRepair.IsNull() {
+		current.WaitForRepair = state.WaitForRepair
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, current)...)
+}
+
+func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+	var plan, state schemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ks, diags := keyspaceFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := tablesFromModel(ctx, ks.Name, plan.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentKeyspace, err := client.GetKeyspace(ks.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Keyspace", err.Error())
+		return
+	}
+	if changes := scylladb.KeyspaceChangesBetween(currentKeyspace, ks); changes.Any() {
+		if changes.Replication {
+			if _, err := client.CheckReplicationDatacenters(ks, true); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("datacenters"), "Error Updating Keyspace", err.Error())
+				return
+			}
+		}
+		if err := client.AlterKeyspaceOptions(ks, changes); err != nil {
+			resp.Diagnostics.AddError("Error Updating Keyspace", err.Error())
+			return
+		}
+		if changes.Replication && plan.WaitForRepair.ValueBool() {
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for the replication of keyspace %s to converge", ks.Name))
+			if err := client.AwaitReplication(ks); err != nil {
+				resp.Diagnostics.AddError("Error Waiting for Replication", err.Error())
+				return
+			}
+		}
+	}
+
+	// Diff against the live tables rather than the state so that drift is corrected too
+	current, err := client.ListTables(ks.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Tables", err.Error())
+		return
+	}
+	for _, table := range slices.Backward(current) {
+		if _, ok := desired[table.Name]; !ok {
+			tflog.Debug(ctx, fmt.Sprintf("Dropping table %s.%s", ks.Name, table.Name))
+			if err := client.DropTable(ks.Name, table.Name); err != nil {
+				resp.Diagnostics.AddError("Error Dropping Table", fmt.Sprintf("Failed to drop table %s.%s: %s", ks.Name, table.Name, err))
+				return
+			}
+		}
+	}
+	existing := make(map[string]scylladb.Table, len(current))
+	for _, table := range current {
+		existing[table.Name] = table
+	}
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		var err error
+		if table, ok := existing[name]; ok {
+			err = client.AlterTableColumns(table, desired[name])
+			if err == nil {
+				err = client.AlterTableProperties(table, desired[name])
+			}
+		} else {
+			err = client.CreateTable(desired[name])
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Updating Table", fmt.Sprintf("Failed to apply table %s.%s: %s", ks.Name, name, err))
+			return
+		}
+	}
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Updating Table", err.Error())
+		return
+	}
+
+	updated, diags := r.readSchema(ctx, ks.Name, plan.Tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if updated == nil {
+		resp.Diagnostics.AddError("Error Updating Schema", fmt.Sprintf("The keyspace %s disappeared during the update.", ks.Name))
+		return
+	}
+	updated.WaitForRepair = plan.WaitForRepair
+	resp.Diagnostics.Append(resp.State.Set(ctx, updated)...)
+}
+
+func (r *schemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state schemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyspace := state.Keyspace.ValueString()
+	tables, err := client.ListTables(keyspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Tables", err.Error())
+		return
+	}
+	for _, table := range slices.Backward(tables) {
+		if err := client.DropTable(keyspace, table.Name); err != nil {
+			resp.Diagnostics.AddError("Error Dropping Table", fmt.Sprintf("Failed to drop table %s.%s: %s", keyspace, table.Name, err))
+			return
+		}
+	}
+	if err := client.AwaitSchemaAgreement(); err != nil {
+		resp.Diagnostics.AddError("Error Dropping Table", err.Error())
+		return
+	}
+	if err := client.DeleteKeyspace(scylladb.Keyspace{Name: keyspace}); err != nil {
+		resp.Diagnostics.AddError("Error Dropping Keyspace", err.Error())
+		return
+	}
+}
+
+func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	state, diags := r.readSchema(ctx, req.ID, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state == nil {
+		resp.Diagnostics.AddError("Keyspace Not Found", fmt.Sprintf("The keyspace %s does not exist.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readSchema reads the keyspace and all of its tables, or returns nil when the keyspace does not
+// exist. Only the table properties set in the matching tables of prior are reported, since the
+// others are not managed; an imported schema therefore reports none.
+func (r *schemaResource) readSchema(ctx context.Context, keyspace string, prior []schemaTableModel) (*schemaResourceModel, diag.Diagnostics) {
+	client := r.client.WithContext(ctx)
+	var diags diag.Diagnostics
+	ks, err := client.GetKeyspace(keyspace)
+	if errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError("Error Reading Keyspace", err.Error())
+		return nil, diags
+	}
+	tables, err := client.ListTables(keyspace)
+	if err != nil {
+		diags.AddError("Error Reading Tables", err.Error())
+		return nil, diags
+	}
+
+	model := &schemaResourceModel{
+		ID:                types.StringValue(keyspace),
+		Keyspace:          types.StringValue(keyspace),
+		ReplicationClass:  types.StringValue(ks.ReplicationClass),
+		ReplicationFactor: types.Int64Null(),
+		Datacenters:       types.MapNull(types.Int64Type),
+		DurableWrites:     types.BoolValue(ks.DurableWrites),
+		WaitForRepair:     types.BoolValue(false),
+		Tables:            []schemaTableModel{},
+	}
+	if ks.ReplicationClass == scylladb.NetworkTopologyStrategy {
+		datacenters, mapDiags := types.MapValueFrom(ctx, types.Int64Type, ks.DatacenterReplication)
+		diags.Append(mapDiags...)
+		model.Datacenters = datacenters
+	} else {
+		model.ReplicationFactor = types.Int64Value(int64(ks.ReplicationFactor))
+	}
+
+	managedProperties := make(map[string]types.Map, len(prior))
+	for _, t := range prior {
+		managedProperties[t.Name.ValueString()] = t.Properties
+	}
+	for _, table := range tables {
+		columns, d := types.MapValueFrom(ctx, types.StringType, table.Columns)
+		diags.Append(d...)
+		partitionKey, d := types.ListValueFrom(ctx, types.StringType, table.PartitionKey)
+		diags.Append(d...)
+		clusteringKey := types.ListNull(types.StringType)
+		if len(table.ClusteringKey) > 0 {
+			clusteringKey, d = types.ListValueFrom(ctx, types.StringType, table.ClusteringKey)
+			diags.Append(d...)
+		}
+		clusteringOrder := types.MapNull(types.StringType)
+		if len(table.ClusteringOrder) > 0 {
+			orders := make(map[string]string, len(table.ClusteringOrder))
+			for _, order := range table.ClusteringOrder {
+				orders[order.Column] = "ASC"
+				if order.Desc {
+					orders[order.Column] = "DESC"
+				}
+			}
+			clusteringOrder, d = types.MapValueFrom(ctx, types.StringType, orders)
+			diags.Append(d...)
+		}
+		properties := types.MapNull(types.StringType)
+		if managed, ok := managedProperties[table.Name]; ok && !managed.IsNull() && !managed.IsUnknown() {
+			values := make(map[string]string)
+			for name := range managed.Elements() {
+				values[name] = table.Properties[name]
+			}
+			properties, d = types.MapValueFrom(ctx, types.StringType, values)
+			diags.Append(d...)
+		}
+		model.Tables = append(model.Tables, schemaTableModel{
+			Name:            types.StringValue(table.Name),
+			Columns:         columns,
+			PartitionKey:    partitionKey,
+			ClusteringKey:   clusteringKey,
+			ClusteringOrder: clusteringOrder,
+			Properties:      properties,
+		})
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return model, diags
+}
+
+func keyspaceFromModel(ctx context.Context, model schemaResourceModel) (scylladb.Keyspace, diag.Diagnostics) {
+	ks := scylladb.Keyspace{
+		Name:              model.Keyspace.ValueString(),
+		ReplicationClass:  model.ReplicationClass.ValueString(),
+		ReplicationFactor: int(model.ReplicationFactor.ValueInt64()),
+		DurableWrites:     model.DurableWrites.ValueBool(),
+	}
+	var diags diag.Diagnostics
+	if !model.Datacenters.IsNull() {
+		var datacenters map[string]int64
+		diags.Append(model.Datacenters.ElementsAs(ctx, &datacenters, false)...)
+		ks.DatacenterReplication = make(map[string]int, len(datacenters))
+		for dc, factor := range datacenters {
+			ks.DatacenterReplication[dc] = int(factor)
+		}
+	}
+	return ks, diags
+}
+
+func tableFromModel(ctx context.Context, keyspace string, model schemaTableModel) (scylladb.Table, diag.Diagnostics) {
+	table := scylladb.Table{Keyspace: keyspace, Name: model.Name.ValueString()}
+	var diags diag.Diagnostics
+	diags.Append(model.Columns.ElementsAs(ctx, &table.Columns, false)...)
+	diags.Append(model.PartitionKey.ElementsAs(ctx, &table.PartitionKey, false)...)
+	if !model.ClusteringKey.IsNull() {
+		diags.Append(model.ClusteringKey.ElementsAs(ctx, &table.ClusteringKey, false)...)
+	}
+	if !model.ClusteringOrder.IsNull() {
+		var orders map[string]string
+		diags.Append(model.ClusteringOrder.ElementsAs(ctx, &orders, false)...)
+		// Follow the clustering key, keeping any other column last so that Validate reports it.
+		columns := slices.SortedFunc(maps.Keys(orders), func(a, b string) int {
+			return cmp.Or(cmp.Compare(clusteringPosition(table.ClusteringKey, a), clusteringPosition(table.ClusteringKey, b)), cmp.Compare(a, b))
+		})
+		for _, column := range columns {
+			table.ClusteringOrder = append(table.ClusteringOrder, scylladb.ClusteringOrder{Column: column, Desc: orders[column] == "DESC"})
+		}
+	}
+	if !model.Properties.IsNull() {
+		diags.Append(model.Properties.ElementsAs(ctx, &table.Properties, false)...)
+	}
+	return table, diags
+}
+
+// clusteringPosition returns the position of column in clusteringKey, or its length when the
+// column is not a clustering column.
+func clusteringPosition(clusteringKey []string, column string) int {
+	if i := slices.Index(clusteringKey, column); i >= 0 {
+		return i
+	}
+	return len(clusteringKey)
+}
+
+// tablesFromModel converts the table blocks, keyed by table name.
+func tablesFromModel(ctx context.Context, keyspace string, models []schemaTableModel) (map[string]scylladb.Table, diag.Diagnostics) {
+	tables := make(map[string]scylladb.Table, len(models))
+	var diags diag.Diagnostics
+	for _, model := range models {
+		table, d := tableFromModel(ctx, keyspace, model)
+		diags.Append(d...)
+		if _, ok := tables[table.Name]; ok {
+			diags.AddAttributeError(path.Root("table"), "Duplicate Table",
+				fmt.Sprintf("The table %s is defined more than once.", table.Name))
+		}
+		tables[table.Name] = table
+	}
+	return tables, diags
+}
+
+// validateReplicationConfig checks that the replication settings of a keyspace match its
+// replication class: SimpleStrategy takes replication_factor and NetworkTopologyStrategy takes
+// datacenters.
+func validateReplicationConfig(replicationClass types.String, replicationFactor types.Int64, datacenters types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if replicationClass.IsUnknown() {
+		return diags
+	}
+	networkTopology := replicationClass.ValueString() == scylladb.NetworkTopologyStrategy
+	switch {
+	case networkTopology && datacenters.IsNull():
+		diags.AddAttributeError(path.Root("datacenters"), "Missing Datacenters",
+			"NetworkTopologyStrategy requires datacenters to set the replication factor of each datacenter.")
+	case networkTopology && !replicationFactor.IsNull():
+		diags.AddAttributeError(path.Root("replication_factor"), "Conflicting Replication Settings",
+			"replication_factor cannot be used with NetworkTopologyStrategy; set datacenters instead.")
+	case !networkTopology && replicationFactor.IsNull():
+		diags.AddAttributeError(path.Root("replication_factor"), "Missing Replication Factor",
+			"SimpleStrategy requires replication_factor.")
+	case !networkTopology && !datacenters.IsNull():
+		diags.AddAttributeError(path.Root("datacenters"), "Conflicting Replication Settings",
+			"datacenters can only be used with NetworkTopologyStrategy.")
+	}
+	return diags
+}
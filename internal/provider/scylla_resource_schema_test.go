@@ -0,0 +1,306 @@
+// Copyright RetailNext, Inc. 2026
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/retailnext/terraform-provider-scylladb/scylladb"
+)
+
+func TestAccSchemaResource(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	config := providerConfig + `
+resource "scylladb_schema" "racing" {
+  keyspace           = "racing"
+  replication_factor = 1
+  table {
+    name          = "riders"
+    columns       = { id = "uuid", name = "text" }
+    partition_key = ["id"]
+  }
+  table {
+    name           = "race_times"
+    columns        = { race_id = "uuid", rank = "int", rider_id = "uuid" }
+    partition_key  = ["race_id"]
+    clustering_key = ["rank"]
+  }
+}
+`
+	alteredConfig := providerConfig + `
+resource "scylladb_schema" "racing" {
+  keyspace           = "racing"
+  replication_factor = 1
+  table {
+    name          = "riders"
+    columns       = { id = "uuid", name = "text", team = "text" }
+    partition_key = ["id"]
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			cluster, err := getTestScyllaClient([]string{devClusterHost})
+			if err != nil {
+				return fmt.Errorf("failed to create cluster client: %w", err)
+			}
+			defer cluster.Session.Close()
+			if _, err := cluster.GetKeyspace("racing"); !errors.Is(err, scylladb.ErrKeyspaceNotFound) {
+				return fmt.Errorf("expected keyspace racing to be dropped, got %v", err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create the keyspace and both tables
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_schema.racing", "id", "racing"),
+					resource.TestCheckResourceAttr("scylladb_schema.racing", "replication_class", "SimpleStrategy"),
+					resource.TestCheckResourceAttr("scylladb_schema.racing", "durable_writes", "true"),
+					resource.TestCheckResourceAttr("scylladb_schema.racing", "table.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("scylladb_schema.racing", "table.*", map[string]string{
+						"name":             "race_times",
+						"partition_key.0":  "race_id",
+						"clustering_key.0": "rank",
+						"columns.rider_id": "uuid",
+					}),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "scylladb_schema.racing",
+				ImportState:       true,
+				ImportStateId:     "racing",
+				ImportStateVerify: true,
+			},
+			// Externally add a column and a table, verify both are removed on apply
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster client: %s", err)
+					}
+					defer cluster.Session.Close()
+					for _, query := range []string{
+						`ALTER TABLE racing.riders ADD nickname text`,
+						`CREATE TABLE racing.teams (id uuid PRIMARY KEY)`,
+					} {
+						if err := cluster.Session.Query(query).Exec(); err != nil {
+							t.Fatalf("failed to change the schema externally: %s", err)
+						}
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_schema.racing", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testCheckSchemaTables(devClusterHost, map[string]int{"race_times": 3, "riders": 2}),
+			},
+			// Add a column and drop a table
+			{
+				Config: alteredConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("scylladb_schema.racing", "table.#", "1"),
+					testCheckSchemaTables(devClusterHost, map[string]int{"riders": 3}),
+				),
+			},
+			// The primary key of an existing table cannot be changed in place
+			{
+				Config: providerConfig + `
+resource "scylladb_schema" "racing" {
+  keyspace           = "racing"
+  replication_factor = 1
+  table {
+    name          = "riders"
+    columns       = { id = "uuid", name = "text", team = "text" }
+    partition_key = ["team"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Unsupported Table Change`),
+			},
+		},
+	})
+}
+
+// testCheckSchemaTables checks that the racing keyspace holds exactly the given tables, each with
+// the given number of columns.
+func testCheckSchemaTables(host string, columns map[string]int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		cluster, err := getTestScyllaClient([]string{host})
+		if err != nil {
+			return fmt.Errorf("failed to create cluster client: %w", err)
+		}
+		defer cluster.Session.Close()
+		tables, err := cluster.ListTables("racing")
+		if err != nil {
+			return fmt.Errorf("failed to list tables: %w", err)
+		}
+		if len(tables) != len(columns) {
+			return fmt.Errorf("expected %d tables, got %v", len(columns), tables)
+		}
+		for _, table := range tables {
+			if len(table.Columns) != columns[table.Name] {
+				return fmt.Errorf("expected table %s to have %d columns, got %v", table.Name, columns[table.Name], table.Columns)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccSchemaResourceTableProperties(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	providerConfig := fmt.Sprintf(providerConfigFmt, devClusterHost)
+
+	configFmt := providerConfig + `
+resource "scylladb_schema" "racing" {
+  keyspace           = "racing"
+  replication_factor = 1
+  table {
+    name             = "standings"
+    columns          = { season = "int", points = "int", rider_id = "uuid" }
+    partition_key    = ["season"]
+    clustering_key   = ["points", "rider_id"]
+    clustering_order = { points = %q, rider_id = %q }
+    properties = {
+      compaction       = "LeveledCompactionStrategy"
+      gc_grace_seconds = %q
+    }
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a table sorted by descending points
+			{
+				Config: fmt.Sprintf(configFmt, "DESC", "ASC", "3600"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs("scylladb_schema.racing", "table.*", map[string]string{
+					"name":                        "standings",
+					"clustering_order.points":     "DESC",
+					"clustering_order.rider_id":   "ASC",
+					"properties.%":                "2",
+					"properties.compaction":       "LeveledCompactionStrategy",
+					"properties.gc_grace_seconds": "3600",
+				}),
+			},
+			// Externally change a managed property, verify the drift is reverted in place
+			{
+				PreConfig: func() {
+					cluster, err := getTestScyllaClient([]string{devClusterHost})
+					if err != nil {
+						t.Fatalf("failed to create cluster client: %s", err)
+					}
+					defer cluster.Session.Close()
+					if err := cluster.Session.Query(`ALTER TABLE racing.standings WITH gc_grace_seconds = 60`).Exec(); err != nil {
+						t.Fatalf("failed to alter the table externally: %s", err)
+					}
+				},
+				Config: fmt.Sprintf(configFmt, "DESC", "ASC", "3600"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_schema.racing", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckTypeSetElemNestedAttrs("scylladb_schema.racing", "table.*", map[string]string{
+					"properties.gc_grace_seconds": "3600",
+				}),
+			},
+			// Change gc_grace_seconds in place
+			{
+				Config: fmt.Sprintf(configFmt, "DESC", "ASC", "7200"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("scylladb_schema.racing", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckTypeSetElemNestedAttrs("scylladb_schema.racing", "table.*", map[string]string{
+					"properties.gc_grace_seconds": "7200",
+				}),
+			},
+			// The clustering order of an existing table cannot be changed in place
+			{
+				Config:      fmt.Sprintf(configFmt, "ASC", "DESC", "7200"),
+				ExpectError: regexp.MustCompile(`Unsupported Table Change`),
+			},
+		},
+	})
+}
+
+// TestSchemaResourceCreatePartial verifies that when a table cannot be created, the keyspace and
+// the tables created before it are saved in the state rather than left untracked.
+func TestSchemaResourceCreatePartial(t *testing.T) {
+	devClusterHost := testutil.NewTestContainer(t)
+	cluster, err := getTestScyllaClient([]string{devClusterHost})
+	if err != nil {
+		t.Fatalf("failed to create cluster client: %s", err)
+	}
+	defer cluster.Session.Close()
+
+	ctx := context.Background()
+	r := &schemaResource{client: cluster}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	table := func(name, idType string) schemaTableModel {
+		return schemaTableModel{
+			Name:            types.StringValue(name),
+			Columns:         types.MapValueMust(types.StringType, map[string]attr.Value{"id": types.StringValue(idType)}),
+			PartitionKey:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("id")}),
+			ClusteringKey:   types.ListNull(types.StringType),
+			ClusteringOrder: types.MapNull(types.StringType),
+			Properties:      types.MapNull(types.StringType),
+		}
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &schemaResourceModel{
+		ID:                types.StringUnknown(),
+		Keyspace:          types.StringValue("partial"),
+		ReplicationClass:  types.StringValue("SimpleStrategy"),
+		ReplicationFactor: types.Int64Value(1),
+		Datacenters:       types.MapNull(types.Int64Type),
+		DurableWrites:     types.BoolValue(true),
+		WaitForRepair:     types.BoolValue(false),
+		Tables:            []schemaTableModel{table("created", "uuid"), table("failed", "no_such_type")},
+	}); diags.HasError() {
+		t.Fatalf("failed to set plan: %v", diags)
+	}
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected creating a table with an unknown column type to fail")
+	}
+
+	var state schemaResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("failed to get state: %v", diags)
+	}
+	if state.Keyspace.ValueString() != "partial" {
+		t.Fatalf("expected keyspace partial in state, got %q", state.Keyspace.ValueString())
+	}
+	if len(state.Tables) != 1 || state.Tables[0].Name.ValueString() != "created" {
+		t.Errorf("expected only table created in state, got %v", state.Tables)
+	}
+}
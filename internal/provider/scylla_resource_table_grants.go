@@ -140,7 +140,7 @@ func (r *tableGrantsResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 	id := scylladb.ParseIdentifier(state.ID.ValueString())
-	roleBindings, err := r.client.GetAllRoleBindingsPerId(id)
+	roleBindings, err := r.client.WithContext(ctx).GetAllRoleBindingsPerId(id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Table Grants", err.Error())
 		return
@@ -172,6 +172,11 @@ func (r *tableGrantsResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *tableGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkDestroyConfirmation(r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	var state tableGrantsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -179,7 +184,7 @@ func (r *tableGrantsResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 	id := scylladb.ParseIdentifier(state.ID.ValueString())
-	if err := r.client.RevokeAllGrantsOnIdentifier(id); err != nil {
+	if err := r.client.WithContext(ctx).RevokeAllGrantsOnIdentifier(id); err != nil {
 		resp.Diagnostics.AddError("Error Revoking Table Grants", err.Error())
 	}
 }
@@ -190,7 +195,7 @@ func (r *tableGrantsResource) ImportState(ctx context.Context, req resource.Impo
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a table identifier in the format 'keyspace.table', got %q", req.ID))
 		return
 	}
-	permissionMap, err := r.client.GetAllRolePermissionsPerId(id)
+	permissionMap, err := r.client.WithContext(ctx).GetAllRolePermissionsPerId(id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Importing Table Grants", err.Error())
 		return
@@ -228,7 +233,7 @@ func (r *tableGrantsResource) applyPlanData(ctx context.Context, plan *tableGran
 		return
 	}
 
-	if err := r.client.ApplyAuthoritativeGrant(id, bindings); err != nil {
+	if err := r.client.WithContext(ctx).ApplyAuthoritativeGrant(id, bindings); err != nil {
 		diags.AddError("Error Applying Table Grants", err.Error())
 		return
 	}
@@ -0,0 +1,57 @@
+// Copyright RetailNext, Inc. 2026
+
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+const (
+	// cqlReadyTimeout bounds how long a new container may take to accept CQL logins.
+	cqlReadyTimeout = 2 * time.Minute
+	// cqlReadyInterval is the delay between readiness attempts.
+	cqlReadyInterval = time.Second
+)
+
+// WaitForCQL blocks until host accepts a CQL login as the default superuser and answers a query,
+// or until timeout elapses. A listening port and the startup log are not enough: the default
+// superuser is created asynchronously, so logins fail for a short while after startup.
+func WaitForCQL(ctx context.Context, host string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if lastErr = pingCQL(ctx, host); lastErr == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s was not ready for CQL after %d attempts: %w", host, attempt, lastErr)
+		case <-time.After(cqlReadyInterval):
+		}
+	}
+}
+
+func pingCQL(ctx context.Context, host string) error {
+	cluster := gocql.NewCluster(host)
+	cluster.DisableInitialHostLookup = true
+	cluster.NumConns = 1
+	cluster.ConnectTimeout = 5 * time.Second
+	cluster.Authenticator = gocql.PasswordAuthenticator{
+		Username: "cassandra",
+		Password: "cassandra",
+	}
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	var releaseVersion string
+	return session.Query("SELECT release_version FROM system.local").ScanContext(ctx, &releaseVersion)
+}
@@ -0,0 +1,35 @@
+// Copyright RetailNext, Inc. 2026
+
+package testutil
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForCQL_Timeout(t *testing.T) {
+	// A listener that accepts connections but never speaks CQL, like a container that is still
+	// starting up.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	start := time.Now()
+	err = WaitForCQL(context.Background(), listener.Addr().String(), 3*time.Second)
+	assert.ErrorContains(t, err, "was not ready for CQL")
+	assert.Less(t, time.Since(start), 15*time.Second)
+}
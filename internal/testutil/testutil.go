@@ -15,8 +15,8 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
-// NewTestContainer starts a ScyllaDB container and returns the host:port string.
-// The container is automatically cleaned up when the test finishes.
+// NewTestContainer starts a ScyllaDB container and returns the host:port string once it accepts
+// CQL logins. The container is automatically cleaned up when the test finishes.
 func NewTestContainer(t *testing.T) string {
 	ctx := context.Background()
 
@@ -58,6 +58,9 @@ func NewTestContainer(t *testing.T) string {
 	if err != nil {
 		t.Fatalf("failed to get the scylla container endpoint: %s", err)
 	}
+	if err := WaitForCQL(ctx, host, cqlReadyTimeout); err != nil {
+		t.Fatalf("failed to wait for the scylla container: %s", err)
+	}
 	return host
 }
 
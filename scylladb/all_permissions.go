@@ -0,0 +1,100 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"cmp"
+	"log"
+	"slices"
+	"strings"
+)
+
+// permissionsPageSize is the number of rows StreamAllPermissions fetches per page.
+var permissionsPageSize = 1000
+
+// StreamAllPermissions lists the permissions granted directly to every role and calls fn for each
+// of them. The result set is fetched page by page, so only one page is held in memory however
+// many grants the cluster has. An error returned by fn stops the iteration and is returned.
+func (c *Cluster) StreamAllPermissions(fn func(Permission) error) error {
+	queryStr := "LIST ALL PERMISSIONS"
+	log.Printf("Executing StreamAllPermissions query: %s", queryStr)
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query(queryStr).PageSize(permissionsPageSize).IterContext(ctx)
+
+	var p Permission
+	for iter.Scan(&p.Role, &p.Username, &p.Resource, &p.Permission) {
+		if err := fn(p); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+	return iter.Close()
+}
+
+// ListRolePermissions lists the permissions of roleName with LIST ALL PERMISSIONS OF, sorted by
+// resource and permission. With recursive, the permissions roleName inherits from the roles it is
+// a member of are included, with the Role of the role holding them; otherwise NORECURSIVE limits
+// the list to the permissions granted to roleName directly.
+func (c *Cluster) ListRolePermissions(roleName string, recursive bool) ([]Permission, error) {
+	if err := validateRoleName(roleName); err != nil {
+		return nil, err
+	}
+	queryStr := "LIST ALL PERMISSIONS OF " + QuoteIdentifier(roleName)
+	if !recursive {
+		queryStr += " NORECURSIVE"
+	}
+	log.Printf("Executing ListRolePermissions query: %s", queryStr)
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query(queryStr).IterContext(ctx)
+
+	var permissions []Permission
+	var p Permission
+	for iter.Scan(&p.Role, &p.Username, &p.Resource, &p.Permission) {
+		permissions = append(permissions, p)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(permissions, func(a, b Permission) int {
+		return cmp.Or(strings.Compare(a.Resource, b.Resource), strings.Compare(a.Permission, b.Permission), strings.Compare(a.Role, b.Role))
+	})
+	return permissions, nil
+}
+
+// Grant returns the grant of the single permission p, parsed from the resource as LIST
+// formats it, e.g. <table cycling.cyclist_name>. It reports false for resources other than data
+// (keyspaces and tables) and roles.
+func (p Permission) Grant() (Grant, bool) {
+	resource, ok := strings.CutPrefix(p.Resource, "<")
+	if !ok {
+		return Grant{}, false
+	}
+	resource, ok = strings.CutSuffix(resource, ">")
+	if !ok {
+		return Grant{}, false
+	}
+	grant := Grant{Privilege: strings.ToUpper(p.Permission), RoleName: p.Role}
+	kind, name, _ := strings.Cut(resource, " ")
+	switch {
+	case resource == "all keyspaces":
+		grant.ResourceType = "ALL KEYSPACES"
+	case resource == "all roles":
+		grant.ResourceType = "ALL ROLES"
+	case kind == "keyspace" && name != "":
+		grant.ResourceType = "KEYSPACE"
+		grant.Keyspace = name
+	case kind == "table" && strings.Contains(name, "."):
+		grant.ResourceType = "TABLE"
+		grant.Keyspace, grant.Identifier, _ = strings.Cut(name, ".")
+	case kind == "role" && name != "":
+		grant.ResourceType = "ROLE"
+		grant.Keyspace = name
+	default:
+		return Grant{}, false
+	}
+	return grant, true
+}
@@ -0,0 +1,113 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionGrant(t *testing.T) {
+	tests := []struct {
+		resource string
+		want     Grant
+		ok       bool
+	}{
+		{"<all keyspaces>", Grant{ResourceType: "ALL KEYSPACES"}, true},
+		{"<keyspace cycling>", Grant{ResourceType: "KEYSPACE", Keyspace: "cycling"}, true},
+		{"<table cycling.cyclist_name>", Grant{ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}, true},
+		{"<all roles>", Grant{ResourceType: "ALL ROLES"}, true},
+		{"<role app>", Grant{ResourceType: "ROLE", Keyspace: "app"}, true},
+		{"<all functions>", Grant{}, false},
+		{"<table cycling>", Grant{}, false},
+		{"data/cycling", Grant{}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.resource, func(t *testing.T) {
+			got, ok := Permission{Role: "r", Resource: tc.resource, Permission: "select"}.Grant()
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				tc.want.RoleName = "r"
+				tc.want.Privilege = "SELECT"
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestStreamAllPermissions(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	// A small page size makes the seeded grants span several pages
+	defer func(pageSize int) { permissionsPageSize = pageSize }(permissionsPageSize)
+	permissionsPageSize = 7
+
+	require.NoError(t, cluster.CreateKeyspace(testKeyspace))
+	const roles = 25
+	privileges := []string{"SELECT", "MODIFY", "ALTER", "DROP"}
+	for i := range roles {
+		role := fmt.Sprintf("stream_role_%d", i)
+		require.NoError(t, cluster.CreateRole(Role{Role: role}))
+		for _, privilege := range privileges {
+			require.NoError(t, cluster.CreateGrant(Grant{RoleName: role, Privilege: privilege, ResourceType: "KEYSPACE", Keyspace: testKeyspace.Name}))
+		}
+	}
+
+	seeded := 0
+	require.NoError(t, cluster.StreamAllPermissions(func(p Permission) error {
+		if grant, ok := p.Grant(); ok && grant.Keyspace == testKeyspace.Name {
+			seeded++
+		}
+		return nil
+	}))
+	assert.Equal(t, roles*len(privileges), seeded)
+
+	// An error from the callback stops the iteration
+	stop := errors.New("stop")
+	calls := 0
+	err := cluster.StreamAllPermissions(func(Permission) error {
+		calls++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestListRolePermissions(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.CreateKeyspace(testKeyspace))
+	require.NoError(t, cluster.CreateRole(Role{Role: "list_parent"}))
+	require.NoError(t, cluster.CreateRole(Role{Role: "list_child", MemberOf: []string{"list_parent"}}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "list_parent", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: testKeyspace.Name}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "list_child", Privilege: "MODIFY", ResourceType: "KEYSPACE", Keyspace: testKeyspace.Name}))
+	resource := fmt.Sprintf("<keyspace %s>", testKeyspace.Name)
+
+	// The username column is only kept for compatibility with Cassandra
+	withoutUsernames := func(permissions []Permission) []Permission {
+		for i := range permissions {
+			permissions[i].Username = ""
+		}
+		return permissions
+	}
+
+	direct, err := cluster.ListRolePermissions("list_child", false)
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{{Role: "list_child", Resource: resource, Permission: "MODIFY"}}, withoutUsernames(direct))
+
+	all, err := cluster.ListRolePermissions("list_child", true)
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{
+		{Role: "list_child", Resource: resource, Permission: "MODIFY"},
+		{Role: "list_parent", Resource: resource, Permission: "SELECT"},
+	}, withoutUsernames(all))
+
+	_, err = cluster.ListRolePermissions(`x" NORECURSIVE`, false)
+	assert.Error(t, err)
+}
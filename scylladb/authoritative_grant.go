@@ -64,7 +64,8 @@ func ParseIdentifier(identifier string) ParsedIdentifier {
 
 // ApplyAuthoritativeGrant reconciles the grants on identifier so they exactly match bindings.
 // Privileges present in the database but absent from bindings are revoked; privileges in
-// bindings but absent from the database are granted.
+// bindings but absent from the database are granted. Each GRANT and REVOKE is its own statement:
+// ScyllaDB only accepts INSERT, UPDATE, and DELETE statements in a batch.
 func (c *Cluster) ApplyAuthoritativeGrant(identifier ParsedIdentifier, bindings []AuthoritativeBinding) error {
 	// Validate bindings first
 	for _, b := range bindings {
@@ -194,13 +195,16 @@ func (c *Cluster) GetAllRolePermissionsPerId(id ParsedIdentifier) (permissionMap
 	queryStr := fmt.Sprintf("SELECT role, permissions FROM %s.role_permissions WHERE resource = ?", c.SystemAuthKeyspaceName)
 	log.Printf("Executing ReadGrant query: %s", queryStr)
 
-	iter := c.Session.Query(queryStr, resourceName).Iter()
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query(queryStr, resourceName).IterContext(ctx)
 
 	permissionMap = make(map[string][]string)
 	var role string
 	var permissions []string
 	for iter.Scan(&role, &permissions) {
-		permissionMap[role] = permissions
+		permissionMap[role] = normalizePermissions(permissions)
+		permissions = nil
 	}
 
 	if err = iter.Close(); err != nil {
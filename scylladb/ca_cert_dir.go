@@ -0,0 +1,48 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// caCertDirExtensions are the extensions of the files ReadCACertDir reads.
+var caCertDirExtensions = []string{".crt", ".pem"}
+
+// ReadCACertDir reads the .pem and .crt files in dir, in the order of their names, and returns
+// the PEM-encoded CA certificates they contain concatenated, as SetTLS takes them. Files that
+// cannot be read or contain no certificate are skipped, with an error for each in skipped, so
+// that a stray file does not break the trust configuration. Subdirectories are not read.
+func ReadCACertDir(dir string) (caCerts []byte, skipped []error, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var certs [][]byte
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(caCertDirExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+		name := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(name)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(data) {
+			skipped = append(skipped, fmt.Errorf("%s contains no PEM-encoded certificate", name))
+			continue
+		}
+		certs = append(certs, bytes.TrimSpace(data))
+	}
+	if len(certs) == 0 {
+		return nil, skipped, nil
+	}
+	return append(bytes.Join(certs, []byte("\n")), '\n'), skipped, nil
+}
@@ -0,0 +1,51 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCACertDir(t *testing.T) {
+	dir := t.TempDir()
+	otherCACert, err := testutil.GenerateTestCACert()
+	require.NoError(t, err)
+	caCertPEM, _, err := caCert.PEMEncodedCert()
+	require.NoError(t, err)
+	otherCACertPEM, _, err := otherCACert.PEMEncodedCert()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), caCertPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.CRT"), otherCACertPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.pem"), []byte("not a certificate"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not read"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.pem"), 0o700))
+
+	caCerts, skipped, err := ReadCACertDir(dir)
+	require.NoError(t, err)
+	require.Len(t, skipped, 1)
+	assert.ErrorContains(t, skipped[0], "broken.pem contains no PEM-encoded certificate")
+
+	cluster, err := NewClusterConfig([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	require.NoError(t, cluster.SetTLS(caCerts, nil, nil, true))
+	want := x509.NewCertPool()
+	require.True(t, want.AppendCertsFromPEM(caCertPEM))
+	require.True(t, want.AppendCertsFromPEM(otherCACertPEM))
+	assert.True(t, want.Equal(cluster.Cluster.SslOpts.Config.RootCAs), "both CA certificates are trusted")
+
+	empty := t.TempDir()
+	caCerts, skipped, err = ReadCACertDir(empty)
+	require.NoError(t, err)
+	assert.Empty(t, caCerts)
+	assert.Empty(t, skipped)
+
+	_, _, err = ReadCACertDir(filepath.Join(dir, "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,138 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ClientCertificate is a PEM-encoded client certificate and its private key.
+type ClientCertificate struct {
+	CertPEM  []byte
+	KeyPEM   []byte
+	NotAfter time.Time
+}
+
+// GenerateClientCertificate issues a client certificate for commonName signed by the given CA.
+// The certificate is valid from now until now+validity, so it expires on its own and no
+// revocation is needed for short-lived credentials.
+func GenerateClientCertificate(caCertPEM, caKeyPEM []byte, commonName string, validity time.Duration) (*ClientCertificate, error) {
+	if commonName == "" {
+		return nil, errors.New("common name must not be empty")
+	}
+	if validity <= 0 {
+		return nil, fmt.Errorf("validity must be positive, got %s", validity)
+	}
+
+	caCert, err := parseCertificatePEM(caCertPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+	if !caCert.IsCA {
+		return nil, errors.New("the CA certificate is not a certificate authority")
+	}
+	caKey, err := parsePrivateKeyPEM(caKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA private key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	// Backdate slightly to tolerate clock skew between the provider and the cluster.
+	now := time.Now()
+	cert := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		NotBefore:   now.Add(-time.Minute),
+		NotAfter:    now.Add(validity).Truncate(time.Second),
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, cert, caCert, &privKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	certBuffer := new(bytes.Buffer)
+	if err := pem.Encode(certBuffer, &pem.Block{Type: "CERTIFICATE", Bytes: certBytes}); err != nil {
+		return nil, err
+	}
+	keyBuffer := new(bytes.Buffer)
+	if err := pem.Encode(keyBuffer, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privKey)}); err != nil {
+		return nil, err
+	}
+	return &ClientCertificate{
+		CertPEM:  certBuffer.Bytes(),
+		KeyPEM:   keyBuffer.Bytes(),
+		NotAfter: cert.NotAfter,
+	}, nil
+}
+
+// DecodeBase64PEM decodes a base64-encoded PEM document, as some secret stores hand out
+// certificates and keys. Whitespace, including line breaks, is ignored. An error is returned
+// when the value is not valid base64 or does not decode to PEM.
+func DecodeBase64PEM(encoded string) ([]byte, error) {
+	if strings.Contains(encoded, "-----BEGIN") {
+		return nil, errors.New("the value is already PEM-encoded, not base64-encoded")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil, fmt.Errorf("the value is not valid base64: %w", err)
+	}
+	if block, _ := pem.Decode(decoded); block == nil {
+		return nil, errors.New("the decoded value is not PEM-encoded")
+	}
+	return decoded, nil
+}
+
+func parseCertificatePEM(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM-encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parsePrivateKeyPEM accepts PKCS#1, PKCS#8, and SEC 1 (EC) encoded private keys.
+func parsePrivateKeyPEM(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM-encoded private key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported private key format: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
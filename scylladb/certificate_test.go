@@ -0,0 +1,83 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateClientCertificate(t *testing.T) {
+	_, caKeyPEM, err := caCert.PEMEncodedCert()
+	require.NoError(t, err)
+
+	issued, err := GenerateClientCertificate(caCertPEM, caKeyPEM, "cassandra", time.Hour)
+	require.NoError(t, err)
+
+	keyPair, err := tls.X509KeyPair(issued.CertPEM, issued.KeyPEM)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caCertPEM))
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "cassandra", leaf.Subject.CommonName)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), leaf.NotAfter, time.Minute)
+	assert.True(t, issued.NotAfter.Equal(leaf.NotAfter))
+}
+
+func TestGenerateClientCertificate_Invalid(t *testing.T) {
+	_, caKeyPEM, err := caCert.PEMEncodedCert()
+	require.NoError(t, err)
+
+	_, err = GenerateClientCertificate(caCertPEM, caKeyPEM, "", time.Hour)
+	assert.ErrorContains(t, err, "common name")
+
+	_, err = GenerateClientCertificate(caCertPEM, caKeyPEM, "cassandra", 0)
+	assert.ErrorContains(t, err, "validity")
+
+	_, err = GenerateClientCertificate([]byte("not a cert"), caKeyPEM, "cassandra", time.Hour)
+	assert.ErrorContains(t, err, "CA certificate")
+
+	// A leaf certificate cannot act as an issuer.
+	_, err = GenerateClientCertificate(clientCertPEM, clientKeyPEM, "cassandra", time.Hour)
+	assert.ErrorContains(t, err, "not a certificate authority")
+}
+
+func TestDecodeBase64PEM(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(caCertPEM)
+	decoded, err := DecodeBase64PEM(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, caCertPEM, decoded)
+
+	// Line-wrapped output, as produced by base64(1), is accepted too
+	var wrapped strings.Builder
+	for chunk := range slices.Chunk([]byte(encoded), 76) {
+		wrapped.Write(chunk)
+		wrapped.WriteString("\n")
+	}
+	decoded, err = DecodeBase64PEM(wrapped.String())
+	require.NoError(t, err)
+	assert.Equal(t, caCertPEM, decoded)
+
+	_, err = DecodeBase64PEM(string(caCertPEM))
+	assert.ErrorContains(t, err, "already PEM-encoded")
+	_, err = DecodeBase64PEM("not base64!")
+	assert.ErrorContains(t, err, "not valid base64")
+	_, err = DecodeBase64PEM(base64.StdEncoding.EncodeToString([]byte("plain text")))
+	assert.ErrorContains(t, err, "not PEM-encoded")
+}
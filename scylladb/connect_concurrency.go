@@ -0,0 +1,70 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"net"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"golang.org/x/net/proxy"
+)
+
+// SetConnectConcurrency limits how many connections to the contact points are established at the
+// same time, and how many of their host names are resolved at the same time. gocql connects to
+// all hosts at once, which can overwhelm a proxy with a long list of contact points; zero keeps
+// that default.
+func (c *Cluster) SetConnectConcurrency(n int) {
+	c.ConnectConcurrency = n
+}
+
+// connectConfig returns the configuration to create the session with: c.Cluster itself, or a
+// copy whose dialer waits for one of ConnectConcurrency slots before each connection attempt.
+// Connections through a proxy and direct TCP connections are limited; a Unix socket is a single
+// connection and is left alone.
+func (c *Cluster) connectConfig() *gocql.ClusterConfig {
+	if c.ConnectConcurrency <= 0 {
+		return c.Cluster
+	}
+	slots := make(chan struct{}, c.ConnectConcurrency)
+	config := *c.Cluster
+	switch hostDialer := config.HostDialer.(type) {
+	case *ProxyHostDialer:
+		limited := *hostDialer
+		limited.proxyDialer = &limitedDialer{proxyDialer: hostDialer.proxyDialer, slots: slots}
+		config.HostDialer = &limited
+	case nil:
+		dialer := config.Dialer
+		if dialer == nil {
+			// The dialer gocql would create
+			dialer = &net.Dialer{Timeout: config.ConnectTimeout, KeepAlive: config.SocketKeepalive}
+		}
+		config.Dialer = &limitedDialer{dialer: dialer, slots: slots}
+	}
+	return &config
+}
+
+// limitedDialer dials with dialer, or proxyDialer through a proxy, while holding one of slots, so
+// that at most cap(slots) connection attempts are in flight at a time.
+type limitedDialer struct {
+	dialer      gocql.Dialer
+	proxyDialer proxy.Dialer
+	slots       chan struct{}
+}
+
+func (d *limitedDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	select {
+	case d.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-d.slots }()
+	return d.dialer.DialContext(ctx, network, addr)
+}
+
+// Dial implements proxy.Dialer for connections through a proxy, which cannot be canceled.
+func (d *limitedDialer) Dial(network, addr string) (net.Conn, error) {
+	d.slots <- struct{}{}
+	defer func() { <-d.slots }()
+	return d.proxyDialer.Dial(network, addr)
+}
@@ -0,0 +1,111 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowDialer connects to a listener after a delay, and records how many dials were in flight at
+// most.
+type slowDialer struct {
+	delay    time.Duration
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (d *slowDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	n := d.inFlight.Add(1)
+	defer d.inFlight.Add(-1)
+	for peak := d.peak.Load(); n > peak && !d.peak.CompareAndSwap(peak, n); peak = d.peak.Load() {
+	}
+	time.Sleep(d.delay)
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, addr)
+}
+
+func TestConnectConfig(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	assert.Same(t, cluster.Cluster, cluster.connectConfig())
+
+	cluster.SetConnectConcurrency(2)
+	config := cluster.connectConfig()
+	assert.NotSame(t, cluster.Cluster, config)
+	assert.IsType(t, &limitedDialer{}, config.Dialer)
+	assert.Nil(t, cluster.Cluster.Dialer, "the configuration of the cluster is not changed")
+
+	proxied, err := NewClusterConfigWithProxy([]string{"10.0.0.1", "10.0.0.2"}, "http://proxy.example.com:3128")
+	require.NoError(t, err)
+	proxied.SetConnectConcurrency(1)
+	hostDialer, ok := proxied.connectConfig().HostDialer.(*ProxyHostDialer)
+	require.True(t, ok)
+	assert.IsType(t, &limitedDialer{}, hostDialer.proxyDialer)
+	assert.Equal(t, proxied.Cluster.HostDialer.(*ProxyHostDialer).hostMap, hostDialer.hostMap)
+}
+
+// TestConnectConcurrency verifies that contact points are connected to concurrently, so many of
+// them connect within a bounded time, without exceeding the configured concurrency.
+func TestConnectConcurrency(t *testing.T) {
+	const contactPoints = 8
+	var addrs []string
+	for range contactPoints {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				conn.Close()
+			}
+		}()
+		addrs = append(addrs, listener.Addr().String())
+	}
+
+	cluster, err := NewClusterConfig(addrs)
+	require.NoError(t, err)
+	dialer := &slowDialer{delay: 100 * time.Millisecond}
+	cluster.Cluster.Dialer = dialer
+	cluster.SetConnectConcurrency(4)
+	config := cluster.connectConfig()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, addr := range addrs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := config.Dialer.DialContext(context.Background(), "tcp", addr)
+			if assert.NoError(t, err) {
+				conn.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	assert.EqualValues(t, 4, dialer.peak.Load())
+	// Two rounds of four, rather than eight dials one after the other
+	assert.GreaterOrEqual(t, elapsed, 200*time.Millisecond)
+	assert.Less(t, elapsed, 600*time.Millisecond)
+}
+
+func TestLimitedDialerCanceled(t *testing.T) {
+	dialer := &limitedDialer{dialer: &slowDialer{}, slots: make(chan struct{}, 1)}
+	dialer.slots <- struct{}{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := dialer.DialContext(ctx, "tcp", "127.0.0.1:9042")
+	assert.ErrorIs(t, err, context.Canceled)
+}
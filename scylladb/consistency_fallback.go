@@ -0,0 +1,91 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// readFallback holds the consistency reads are retried at and the session used for it, which is
+// only opened on the first fallback.
+type readFallback struct {
+	consistency gocql.Consistency
+
+	mu      sync.Mutex
+	cluster *Cluster
+}
+
+// SetReadConsistencyFallback makes ReadWithFallback retry reads at consistency when they time out
+// at the configured consistency, e.g. LOCAL_ONE while a node is down. An empty consistency
+// disables the fallback.
+func (c *Cluster) SetReadConsistencyFallback(consistency string) error {
+	if consistency == "" {
+		c.readFallback = nil
+		return nil
+	}
+	parsed, err := gocql.ParseConsistencyWrapper(strings.ToUpper(consistency))
+	if err != nil {
+		return err
+	}
+	c.readFallback = &readFallback{consistency: parsed}
+	return nil
+}
+
+// ReadWithFallback calls read with c. When the read fails because not enough replicas answered
+// in time and a fallback consistency is set, read is called again with a cluster querying at the
+// fallback consistency, and fellBack is set: the result may be stale. read must only read, since
+// writes at a weaker consistency are not safe to retry.
+func (c *Cluster) ReadWithFallback(read func(*Cluster) error) (fellBack bool, err error) {
+	err = read(c)
+	if err == nil || c.readFallback == nil || !isReadConsistencyError(err) {
+		return false, err
+	}
+	log.Printf("Read at %s failed, retrying at %s: %s", c.Cluster.Consistency, c.readFallback.consistency, err)
+	fallback, fallbackErr := c.fallbackCluster()
+	if fallbackErr != nil {
+		return false, errors.Join(err, fmt.Errorf("failed to connect at fallback consistency %s: %w", c.readFallback.consistency, fallbackErr))
+	}
+	// The fallback cluster is shared, so apply an overridden auth keyspace of c to it
+	return true, read(fallback.WithSystemAuthKeyspace(c.SystemAuthKeyspaceName))
+}
+
+// fallbackCluster returns a cluster with the same settings as c whose queries default to the
+// fallback consistency. gocql sets the consistency per session, so it has a session of its own.
+func (c *Cluster) fallbackCluster() (*Cluster, error) {
+	c.readFallback.mu.Lock()
+	defer c.readFallback.mu.Unlock()
+	if c.readFallback.cluster != nil {
+		return c.readFallback.cluster, nil
+	}
+	config := *c.Cluster
+	config.Consistency = c.readFallback.consistency
+	config.PoolConfig.HostSelectionPolicy = c.newHostSelectionPolicy()
+	fallback := &Cluster{
+		Cluster:                &config,
+		SystemAuthKeyspaceName: c.SystemAuthKeyspaceName,
+		RequestTimeout:         c.RequestTimeout,
+		DDLTimeout:             c.DDLTimeout,
+		GrantVerifyAttempts:    c.GrantVerifyAttempts,
+		// Fallback reads count towards the metrics of c
+		metrics: c.metrics,
+	}
+	if err := fallback.CreateSession(); err != nil {
+		return nil, err
+	}
+	c.readFallback.cluster = fallback
+	return fallback, nil
+}
+
+// isReadConsistencyError reports whether err means that too few replicas answered a read to
+// satisfy its consistency, as opposed to e.g. a syntax or permission error.
+func isReadConsistencyError(err error) bool {
+	var readTimeout *gocql.RequestErrReadTimeout
+	var unavailable *gocql.RequestErrUnavailable
+	return errors.As(err, &readTimeout) || errors.As(err, &unavailable)
+}
@@ -0,0 +1,85 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReadConsistencyFallback(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+
+	require.NoError(t, cluster.SetReadConsistencyFallback("local_one"))
+	assert.Equal(t, "LOCAL_ONE", cluster.EffectiveConfig()["read_consistency_fallback"])
+
+	assert.Error(t, cluster.SetReadConsistencyFallback("SOME"))
+
+	require.NoError(t, cluster.SetReadConsistencyFallback(""))
+	assert.NotContains(t, cluster.EffectiveConfig(), "read_consistency_fallback")
+}
+
+func TestReadWithFallback(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	cluster.Cluster.Consistency = gocql.Quorum
+
+	// Reads at QUORUM time out, as when a replica is down; reads at the fallback succeed
+	quorumTimeout := &gocql.RequestErrReadTimeout{Consistency: gocql.Quorum, Received: 1, BlockFor: 2}
+	var consistencies []gocql.Consistency
+	read := func(c *Cluster) error {
+		consistencies = append(consistencies, c.Cluster.Consistency)
+		if c.Cluster.Consistency == gocql.Quorum {
+			return fmt.Errorf("failed to read roles: %w", quorumTimeout)
+		}
+		return nil
+	}
+
+	// Without a fallback the timeout is returned as is
+	fellBack, err := cluster.ReadWithFallback(read)
+	assert.ErrorIs(t, err, quorumTimeout)
+	assert.False(t, fellBack)
+	assert.Equal(t, []gocql.Consistency{gocql.Quorum}, consistencies)
+
+	require.NoError(t, cluster.SetReadConsistencyFallback("LOCAL_ONE"))
+	config := *cluster.Cluster
+	config.Consistency = gocql.LocalOne
+	// Stands in for the session fallbackCluster would open
+	cluster.readFallback.cluster = &Cluster{Cluster: &config}
+
+	consistencies = nil
+	fellBack, err = cluster.ReadWithFallback(read)
+	assert.NoError(t, err)
+	assert.True(t, fellBack)
+	assert.Equal(t, []gocql.Consistency{gocql.Quorum, gocql.LocalOne}, consistencies)
+
+	// Unavailable replicas fall back too
+	consistencies = nil
+	fellBack, err = cluster.ReadWithFallback(func(c *Cluster) error {
+		consistencies = append(consistencies, c.Cluster.Consistency)
+		if c.Cluster.Consistency == gocql.Quorum {
+			return &gocql.RequestErrUnavailable{Consistency: gocql.Quorum, Required: 2, Alive: 1}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, fellBack)
+	assert.Len(t, consistencies, 2)
+
+	// Other errors are not retried
+	boom := errors.New("boom")
+	consistencies = nil
+	fellBack, err = cluster.ReadWithFallback(func(c *Cluster) error {
+		consistencies = append(consistencies, c.Cluster.Consistency)
+		return boom
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.False(t, fellBack)
+	assert.Len(t, consistencies, 1)
+}
@@ -0,0 +1,84 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// coordinatorPin remembers the host that coordinates all statements written through exec and
+// execDDL.
+type coordinatorPin struct {
+	mu     sync.Mutex
+	hostID string
+}
+
+// SetPinWritesToCoordinator makes schema and data changing statements run on a single coordinator,
+// the first contact point that is up, instead of the host the load balancing policy picks for
+// each of them. Role and permission changes then propagate from one node, so a later statement
+// does not race a change that another coordinator has not applied yet. Reads keep using the
+// load balancing policy. When the coordinator goes down, the next contact point that is up takes
+// over.
+func (c *Cluster) SetPinWritesToCoordinator(pin bool) {
+	if !pin {
+		c.coordinator = nil
+		return
+	}
+	c.coordinator = &coordinatorPin{}
+}
+
+// writeQuery returns a query for a schema or data changing statement, pinned to the coordinator
+// when SetPinWritesToCoordinator is enabled.
+func (c *Cluster) writeQuery(stmt string, values ...any) *gocql.Query {
+	query := c.WriteSession().Query(stmt, values...)
+	if c.coordinator != nil {
+		query.SetHostID(c.coordinator.pick(c.Cluster.Hosts, c.WriteSession().GetHosts()))
+	}
+	return query
+}
+
+// pick returns the ID of the pinned host, choosing a new one with pickCoordinator when it is not
+// up anymore. An empty ID leaves the choice to the load balancing policy.
+func (p *coordinatorPin) pick(contactPoints []string, hosts []*gocql.HostInfo) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.hostID != "" && slices.ContainsFunc(hosts, func(host *gocql.HostInfo) bool {
+		return host.HostID() == p.hostID && host.IsUp()
+	}) {
+		return p.hostID
+	}
+	p.hostID = pickCoordinator(contactPoints, hosts)
+	return p.hostID
+}
+
+// pickCoordinator returns the ID of the host of the first contact point that is up. When none of
+// the contact points is known by address, e.g. the dummy hosts of a proxy, the up host with the
+// lowest ID is used so that the choice is still stable.
+func pickCoordinator(contactPoints []string, hosts []*gocql.HostInfo) string {
+	up := slices.DeleteFunc(slices.Clone(hosts), func(host *gocql.HostInfo) bool {
+		return !host.IsUp() || host.HostID() == ""
+	})
+	for _, contactPoint := range contactPoints {
+		address, _, err := net.SplitHostPort(contactPoint)
+		if err != nil {
+			address = contactPoint
+		}
+		ip := net.ParseIP(address)
+		for _, host := range up {
+			if ip != nil && host.ConnectAddress().Equal(ip) {
+				return host.HostID()
+			}
+		}
+	}
+	if len(up) == 0 {
+		return ""
+	}
+	return slices.MinFunc(up, func(a, b *gocql.HostInfo) int {
+		return strings.Compare(a.HostID(), b.HostID())
+	}).HostID()
+}
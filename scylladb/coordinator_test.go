@@ -0,0 +1,57 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHost(t *testing.T, hostID, address string) *gocql.HostInfo {
+	host, err := gocql.NewTestHostInfoFromRow(map[string]any{"host_id": hostID, "rpc_address": address})
+	require.NoError(t, err)
+	return host
+}
+
+func TestPickCoordinator(t *testing.T) {
+	a := newTestHost(t, "00000000-0000-0000-0000-00000000000a", "10.0.0.1")
+	b := newTestHost(t, "00000000-0000-0000-0000-00000000000b", "10.0.0.2")
+	c := newTestHost(t, "00000000-0000-0000-0000-00000000000c", "10.0.0.3")
+	hosts := []*gocql.HostInfo{c, b, a}
+
+	// The first contact point that is known wins, with or without a port
+	assert.Equal(t, b.HostID(), pickCoordinator([]string{"10.0.0.9", "10.0.0.2:9042", "10.0.0.1"}, hosts))
+	assert.Equal(t, c.HostID(), pickCoordinator([]string{"10.0.0.3"}, hosts))
+	// Unknown contact points, such as the dummy hosts of a proxy, fall back to the lowest host ID
+	assert.Equal(t, a.HostID(), pickCoordinator([]string{"127.0.0.1:9042"}, hosts))
+	assert.Empty(t, pickCoordinator([]string{"10.0.0.1"}, nil))
+}
+
+func TestCoordinatorPinKeepsHost(t *testing.T) {
+	a := newTestHost(t, "00000000-0000-0000-0000-00000000000a", "10.0.0.1")
+	b := newTestHost(t, "00000000-0000-0000-0000-00000000000b", "10.0.0.2")
+
+	pin := &coordinatorPin{}
+	assert.Equal(t, b.HostID(), pin.pick([]string{"10.0.0.2"}, []*gocql.HostInfo{a, b}))
+	// The pinned host is kept even when the contact points would now pick another one
+	assert.Equal(t, b.HostID(), pin.pick([]string{"10.0.0.1"}, []*gocql.HostInfo{a, b}))
+	// and replaced once it is gone
+	assert.Equal(t, a.HostID(), pin.pick([]string{"10.0.0.1"}, []*gocql.HostInfo{a}))
+}
+
+func TestSetPinWritesToCoordinator(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"10.0.0.1"})
+	require.NoError(t, err)
+	assert.Nil(t, cluster.coordinator)
+	assert.NotContains(t, cluster.EffectiveConfig(), "pin_writes_to_coordinator")
+
+	cluster.SetPinWritesToCoordinator(true)
+	assert.NotNil(t, cluster.coordinator)
+	assert.Equal(t, true, cluster.EffectiveConfig()["pin_writes_to_coordinator"])
+
+	cluster.SetPinWritesToCoordinator(false)
+	assert.Nil(t, cluster.coordinator)
+}
@@ -0,0 +1,60 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+// DDLMode controls whether CREATE and DROP statements include IF NOT EXISTS and IF EXISTS, that
+// is whether they succeed or fail when the object already exists or is already gone.
+type DDLMode int
+
+const (
+	// DDLModeDefault keeps the clauses each method has always used: CreateKeyspace,
+	// DeleteKeyspace, and DropTable are idempotent, while CreateKeyspaceStrict, CreateTable,
+	// CreateRole, and DeleteRole fail on conflict.
+	DDLModeDefault DDLMode = iota
+	// DDLModeIdempotent adds IF NOT EXISTS and IF EXISTS to every CREATE and DROP.
+	DDLModeIdempotent
+	// DDLModeStrict leaves them out, so every CREATE and DROP fails on conflict.
+	DDLModeStrict
+)
+
+func (m DDLMode) String() string {
+	switch m {
+	case DDLModeIdempotent:
+		return "idempotent"
+	case DDLModeStrict:
+		return "strict"
+	default:
+		return "default"
+	}
+}
+
+// idempotentDDL reports whether a statement should include IF [NOT] EXISTS, given whether the
+// method issuing it is idempotent in DDLModeDefault.
+func (c *Cluster) idempotentDDL(idempotentByDefault bool) bool {
+	switch c.DDLMode {
+	case DDLModeIdempotent:
+		return true
+	case DDLModeStrict:
+		return false
+	default:
+		return idempotentByDefault
+	}
+}
+
+// ifNotExists returns the IF NOT EXISTS clause, followed by a space, for a CREATE statement that
+// should be idempotent, and "" otherwise.
+func (c *Cluster) ifNotExists(idempotentByDefault bool) string {
+	if c.idempotentDDL(idempotentByDefault) {
+		return "IF NOT EXISTS "
+	}
+	return ""
+}
+
+// ifExists returns the IF EXISTS clause, followed by a space, for a DROP statement that should be
+// idempotent, and "" otherwise.
+func (c *Cluster) ifExists(idempotentByDefault bool) string {
+	if c.idempotentDDL(idempotentByDefault) {
+		return "IF EXISTS "
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDDLMode(t *testing.T) {
+	cluster := &Cluster{}
+	assert.Equal(t, "IF NOT EXISTS ", cluster.ifNotExists(true))
+	assert.Equal(t, "", cluster.ifNotExists(false))
+	assert.Equal(t, "IF EXISTS ", cluster.ifExists(true))
+	assert.Equal(t, "", cluster.ifExists(false))
+	assert.Equal(t, "default", cluster.DDLMode.String())
+
+	cluster.DDLMode = DDLModeIdempotent
+	assert.Equal(t, "IF NOT EXISTS ", cluster.ifNotExists(false))
+	assert.Equal(t, "IF EXISTS ", cluster.ifExists(false))
+	assert.Equal(t, "idempotent", cluster.DDLMode.String())
+
+	cluster.DDLMode = DDLModeStrict
+	assert.Equal(t, "", cluster.ifNotExists(true))
+	assert.Equal(t, "", cluster.ifExists(true))
+	assert.Equal(t, "strict", cluster.DDLMode.String())
+}
@@ -0,0 +1,54 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// DialOptions are socket-level settings for connecting directly to the cluster.
+type DialOptions struct {
+	// Timeout bounds establishing a TCP connection. Zero uses the cluster's ConnectTimeout.
+	Timeout time.Duration
+	// LocalAddr is the local IP address to dial from. Empty lets the operating system choose.
+	LocalAddr string
+	// DisableDualStack turns off the fallback to the other IP family ("Happy Eyeballs") when a
+	// host resolves to both IPv4 and IPv6 addresses, so only the first family is tried.
+	DisableDualStack bool
+}
+
+// SetDialer configures the dialer gocql uses for direct TCP connections. It is not supported when
+// connecting through a proxy or to a Unix socket, since their own dialers establish those
+// connections instead.
+func (c *Cluster) SetDialer(opts DialOptions) error {
+	if _, ok := c.Cluster.HostDialer.(*ProxyHostDialer); ok {
+		return errors.New("dial settings are not supported when connecting through a proxy")
+	}
+	if _, ok := c.Cluster.HostDialer.(*UnixSocketDialer); ok {
+		return errors.New("dial settings are not supported with a Unix socket host")
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   opts.Timeout,
+		KeepAlive: c.Cluster.SocketKeepalive,
+	}
+	if dialer.Timeout == 0 {
+		dialer.Timeout = c.Cluster.ConnectTimeout
+	}
+	if opts.LocalAddr != "" {
+		ip := net.ParseIP(opts.LocalAddr)
+		if ip == nil {
+			return fmt.Errorf("invalid local address %q: must be an IP address", opts.LocalAddr)
+		}
+		dialer.LocalAddr = &net.TCPAddr{IP: ip}
+	}
+	if opts.DisableDualStack {
+		// A negative fallback delay disables the dual-stack fallback.
+		dialer.FallbackDelay = -1
+	}
+	c.Cluster.Dialer = dialer
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDialer(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+
+	require.NoError(t, cluster.SetDialer(DialOptions{
+		Timeout:          3 * time.Second,
+		LocalAddr:        "127.0.0.1",
+		DisableDualStack: true,
+	}))
+	dialer, ok := cluster.Cluster.Dialer.(*net.Dialer)
+	require.True(t, ok, "expected a *net.Dialer, got %T", cluster.Cluster.Dialer)
+	assert.Equal(t, 3*time.Second, dialer.Timeout)
+	assert.Equal(t, &net.TCPAddr{IP: net.ParseIP("127.0.0.1")}, dialer.LocalAddr)
+	assert.Negative(t, dialer.FallbackDelay)
+	config := cluster.EffectiveConfig()
+	assert.Equal(t, "127.0.0.1:0", config["local_addr"])
+	assert.Equal(t, false, config["dual_stack"])
+
+	// Without a timeout the connect timeout applies
+	require.NoError(t, cluster.SetDialer(DialOptions{}))
+	assert.Equal(t, cluster.Cluster.ConnectTimeout, cluster.Cluster.Dialer.(*net.Dialer).Timeout)
+}
+
+func TestSetDialer_Invalid(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+	assert.Error(t, cluster.SetDialer(DialOptions{LocalAddr: "not-an-ip"}))
+
+	proxied, err := NewClusterConfigWithProxy([]string{"scylla.example.com"}, "http://proxy:3128")
+	require.NoError(t, err)
+	assert.Error(t, proxied.SetDialer(DialOptions{Timeout: time.Second}))
+}
+
+func TestSetDialer_UsedWithoutProxy(t *testing.T) {
+	// Dial from a loopback address other than the default to tell the custom dialer apart.
+	const localAddr = "127.0.0.2"
+	probe, err := net.Listen("tcp", net.JoinHostPort(localAddr, "0"))
+	if err != nil {
+		t.Skipf("%s is not available on this host: %s", localAddr, err)
+	}
+	probe.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	remoteAddrs := make(chan net.Addr, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		remoteAddrs <- conn.RemoteAddr()
+		conn.Close()
+	}()
+
+	cluster, err := NewClusterConfig([]string{listener.Addr().String()})
+	require.NoError(t, err)
+	cluster.Cluster.ConnectTimeout = time.Second
+	require.NoError(t, cluster.SetDialer(DialOptions{LocalAddr: localAddr}))
+
+	// The listener does not speak CQL, so creating the session fails after dialing.
+	assert.Error(t, cluster.CreateSession())
+	select {
+	case addr := <-remoteAddrs:
+		assert.Equal(t, localAddr, addr.(*net.TCPAddr).IP.String())
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cluster never dialed the listener")
+	}
+}
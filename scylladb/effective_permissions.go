@@ -0,0 +1,109 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"slices"
+	"strings"
+)
+
+// GetRoleEffectivePermissions returns the sorted privileges roleName holds on resource, including
+// those inherited from the roles it is a member of (transitively) and those granted on the
+// enclosing resources, e.g. on the keyspace of a table or on ALL KEYSPACES. Superusers hold every
+// privilege. Only the ResourceType, Keyspace, and Identifier of resource are used.
+func (c *Cluster) GetRoleEffectivePermissions(roleName string, resource Grant) ([]string, error) {
+	return c.roleEffectivePermissions(roleName, resource, true)
+}
+
+// GetRoleInheritedPermissions is like GetRoleEffectivePermissions, but leaves out the privileges
+// granted to roleName itself on resource. These are the privileges the role would still hold on
+// resource without any grant of its own on it.
+func (c *Cluster) GetRoleInheritedPermissions(roleName string, resource Grant) ([]string, error) {
+	return c.roleEffectivePermissions(roleName, resource, false)
+}
+
+func (c *Cluster) roleEffectivePermissions(roleName string, resource Grant, direct bool) ([]string, error) {
+	applicable := Grant{Privilege: "ALL PERMISSIONS", ResourceType: resource.ResourceType}.GetExpandedPermissions()
+	roles, superuser, err := c.inheritedRoles(roleName)
+	if err != nil {
+		return nil, err
+	}
+	if superuser {
+		return applicable, nil
+	}
+
+	granted := make(map[string]bool)
+	for i, target := range resourceHierarchy(resource) {
+		permissionMap, err := c.GetAllRolePermissionsPerId(ParsedIdentifier{
+			ResourceType: target.ResourceType,
+			Keyspace:     target.Keyspace,
+			Table:        target.Identifier,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			if !direct && i == 0 && role == roleName {
+				continue
+			}
+			for _, permission := range permissionMap[role] {
+				granted[strings.ToUpper(permission)] = true
+			}
+		}
+	}
+
+	// Privileges granted on an enclosing resource that do not apply to resource, such as
+	// CREATE on the keyspace of a table, are left out.
+	permissions := []string{}
+	for _, permission := range applicable {
+		if granted[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+// inheritedRoles returns roleName and every role it is a member of, directly or transitively,
+// and whether any of them is a superuser.
+func (c *Cluster) inheritedRoles(roleName string) (roles []string, superuser bool, err error) {
+	queue := []string{roleName}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if slices.Contains(roles, name) {
+			continue
+		}
+		role, err := c.GetRole(name)
+		if err != nil {
+			return nil, false, err
+		}
+		roles = append(roles, name)
+		superuser = superuser || role.IsSuperuser
+		queue = append(queue, role.MemberOf...)
+	}
+	return roles, superuser, nil
+}
+
+// resourceHierarchy returns resource followed by the resources that enclose it.
+func resourceHierarchy(resource Grant) []Grant {
+	switch strings.ToUpper(resource.ResourceType) {
+	case "TABLE":
+		return []Grant{
+			{ResourceType: "TABLE", Keyspace: resource.Keyspace, Identifier: resource.Identifier},
+			{ResourceType: "KEYSPACE", Keyspace: resource.Keyspace},
+			{ResourceType: "ALL KEYSPACES"},
+		}
+	case "KEYSPACE":
+		return []Grant{
+			{ResourceType: "KEYSPACE", Keyspace: resource.Keyspace},
+			{ResourceType: "ALL KEYSPACES"},
+		}
+	case "ROLE":
+		return []Grant{
+			{ResourceType: "ROLE", Keyspace: resource.Keyspace},
+			{ResourceType: "ALL ROLES"},
+		}
+	default:
+		return []Grant{{ResourceType: strings.ToUpper(resource.ResourceType)}}
+	}
+}
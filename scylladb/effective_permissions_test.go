@@ -0,0 +1,84 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceHierarchy(t *testing.T) {
+	assert.Equal(t, []Grant{
+		{ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"},
+		{ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{ResourceType: "ALL KEYSPACES"},
+	}, resourceHierarchy(Grant{ResourceType: "table", Keyspace: "cycling", Identifier: "cyclist_name", RoleName: "ignored"}))
+	assert.Equal(t, []Grant{
+		{ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{ResourceType: "ALL KEYSPACES"},
+	}, resourceHierarchy(Grant{ResourceType: "KEYSPACE", Keyspace: "cycling"}))
+	assert.Equal(t, []Grant{{ResourceType: "ALL KEYSPACES"}}, resourceHierarchy(Grant{ResourceType: "ALL KEYSPACES"}))
+}
+
+func TestGetRoleEffectivePermissions(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	setupTestKSAndTable(t, cluster)
+
+	for _, name := range []string{"readers", "analyst", "outsider"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	require.NoError(t, cluster.Session.Query(`GRANT readers TO analyst`).Exec())
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "readers", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "readers", Privilege: "CREATE", ResourceType: "KEYSPACE", Keyspace: "cycling"}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "analyst", Privilege: "MODIFY", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}))
+
+	table := Grant{ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}
+
+	// SELECT is inherited from readers on the enclosing keyspace; CREATE does not apply to tables
+	permissions, err := cluster.GetRoleEffectivePermissions("analyst", table)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MODIFY", "SELECT"}, permissions)
+
+	permissions, err = cluster.GetRoleEffectivePermissions("analyst", Grant{ResourceType: "KEYSPACE", Keyspace: "cycling"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CREATE", "SELECT"}, permissions)
+
+	permissions, err = cluster.GetRoleEffectivePermissions("outsider", table)
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+
+	permissions, err = cluster.GetRoleEffectivePermissions("cassandra", table)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ALTER", "AUTHORIZE", "DROP", "MODIFY", "SELECT"}, permissions)
+
+	_, err = cluster.GetRoleEffectivePermissions("it_should_not_exist", table)
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
+
+func TestGetRoleInheritedPermissions(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	setupTestKSAndTable(t, cluster)
+
+	for _, name := range []string{"readers", "analyst"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	require.NoError(t, cluster.Session.Query(`GRANT readers TO analyst`).Exec())
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "readers", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "analyst", Privilege: "ALTER", ResourceType: "KEYSPACE", Keyspace: "cycling"}))
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "analyst", Privilege: "MODIFY", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}))
+
+	// The own grant of analyst on the table is left out, but its grant on the keyspace is not
+	permissions, err := cluster.GetRoleInheritedPermissions("analyst", Grant{ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ALTER", "SELECT"}, permissions)
+
+	permissions, err = cluster.GetRoleInheritedPermissions("analyst", Grant{ResourceType: "KEYSPACE", Keyspace: "cycling"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SELECT"}, permissions)
+}
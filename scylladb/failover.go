@@ -0,0 +1,59 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+const (
+	// defaultReconnectInterval is how often gocql retries the hosts that are down by default.
+	defaultReconnectInterval = time.Minute
+	// failoverReconnectInterval is how often they are retried when failing over is enabled.
+	failoverReconnectInterval = time.Second
+	// failoverWait bounds how long an operation waits for a host to be up again.
+	failoverWait = 10 * time.Second
+	// failoverPollInterval is how often the hosts are checked while waiting.
+	failoverPollInterval = 100 * time.Millisecond
+)
+
+// SetFailoverContactPoints makes the cluster fail over to another contact point when the ones it
+// uses go down, e.g. during node maintenance. gocql connects to every contact point, but only
+// retries those that are down once a minute; with host lookup disabled it knows no other host, so
+// operations fail until then. With failing over enabled, the contact points that are down are
+// retried every second, and an operation started while none of them is up waits for up to 10s
+// for one to be reconnected instead of failing right away.
+func (c *Cluster) SetFailoverContactPoints(enabled bool) {
+	c.failover = enabled
+	c.Cluster.ReconnectInterval = defaultReconnectInterval
+	if enabled {
+		c.Cluster.ReconnectInterval = failoverReconnectInterval
+	}
+}
+
+// awaitContactPoint waits until a host of the session is up when failing over is enabled and
+// none is, or until failoverWait elapses or ctx is done. The operation then runs, and fails, as it
+// would have.
+func (c *Cluster) awaitContactPoint(ctx context.Context) {
+	if !c.failover || c.Session == nil || anyHostUp(c.Session) {
+		return
+	}
+	log.Printf("No contact point is up, waiting up to %s for one to be reconnected", failoverWait)
+	deadline := time.Now().Add(failoverWait)
+	for time.Now().Before(deadline) && ctx.Err() == nil {
+		time.Sleep(failoverPollInterval)
+		if anyHostUp(c.Session) {
+			return
+		}
+	}
+}
+
+// anyHostUp reports whether session has a host that is up.
+func anyHostUp(session *gocql.Session) bool {
+	return slices.ContainsFunc(session.GetHosts(), (*gocql.HostInfo).IsUp)
+}
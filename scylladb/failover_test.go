@@ -0,0 +1,109 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// forwarder relays the TCP connections it accepts to target. Closing it also drops the
+// connections it relays, as if the host behind it went down.
+type forwarder struct {
+	listener net.Listener
+	target   string
+
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func newForwarder(t *testing.T, target string) *forwarder {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &forwarder{listener: listener, target: target}
+	t.Cleanup(f.Close)
+	go f.serve()
+	return f
+}
+
+func (f *forwarder) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		upstream, err := net.Dial("tcp", f.target)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+		f.mu.Lock()
+		f.conns = append(f.conns, conn, upstream)
+		f.mu.Unlock()
+		go func() {
+			_, _ = io.Copy(upstream, conn)
+			upstream.Close()
+		}()
+		go func() {
+			_, _ = io.Copy(conn, upstream)
+			conn.Close()
+		}()
+	}
+}
+
+func (f *forwarder) addr() string {
+	return f.listener.Addr().String()
+}
+
+func (f *forwarder) Close() {
+	f.listener.Close()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, conn := range f.conns {
+		conn.Close()
+	}
+}
+
+func TestSetFailoverContactPoints(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.NotContains(t, cluster.EffectiveConfig(), "failover_contact_points")
+
+	cluster.SetFailoverContactPoints(true)
+	assert.Equal(t, failoverReconnectInterval, cluster.Cluster.ReconnectInterval)
+	assert.Equal(t, true, cluster.EffectiveConfig()["failover_contact_points"])
+
+	cluster.SetFailoverContactPoints(false)
+	assert.Equal(t, defaultReconnectInterval, cluster.Cluster.ReconnectInterval)
+}
+
+// TestFailoverContactPoints verifies that operations continue on the second contact point when
+// the first one goes down. Both contact points relay to the same node.
+func TestFailoverContactPoints(t *testing.T) {
+	host := testutil.NewTestContainer(t)
+	first, second := newForwarder(t, host), newForwarder(t, host)
+	cluster, err := NewClusterConfig([]string{first.addr(), second.addr()})
+	require.NoError(t, err)
+	cluster.SetSystemAuthKeyspace("system")
+	cluster.SetUserPasswordAuth("cassandra", "cassandra")
+	cluster.SetFailoverContactPoints(true)
+	require.NoError(t, cluster.CreateSession())
+	defer cluster.Close()
+
+	_, err = cluster.GetRole("cassandra")
+	require.NoError(t, err)
+
+	first.Close()
+	for range 5 {
+		_, err = cluster.GetRole("cassandra")
+		require.NoError(t, err)
+	}
+	require.NoError(t, cluster.CreateRole(Role{Role: "failover"}))
+	require.NoError(t, cluster.DeleteRole(Role{Role: "failover"}))
+}
@@ -7,22 +7,32 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"slices"
 	"strings"
 	"text/template"
 
 	gocql "github.com/apache/cassandra-gocql-driver/v2"
 )
 
+// The grant templates quote every name with QuoteIdentifier, like the role statements in roles.go,
+// so that a role is referred to by the same name everywhere. The identifier of a grant on a FUNCTION
+// is its signature, of which only the name is quoted.
 const (
-	deleteGrantTemplate = `REVOKE {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}"{{ .Keyspace}}"{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}"{{.Identifier}}"{{end}} FROM "{{.RoleName}}"`
-	createGrantTemplate = `GRANT {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}"{{ .Keyspace}}"{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}"{{.Identifier}}"{{end}} TO "{{.RoleName}}"`
-	readGrantTemplate   = `LIST {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}"{{ .Keyspace }}"{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}"{{.Identifier}}"{{end}} OF "{{.RoleName}}"`
+	deleteGrantTemplate = `REVOKE {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}{{quote .Keyspace}}{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}{{identifier .}}{{end}} FROM {{quote .RoleName}}`
+	createGrantTemplate = `GRANT {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}{{quote .Keyspace}}{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}{{identifier .}}{{end}} TO {{quote .RoleName}}`
+	readGrantTemplate   = `LIST {{ .Privilege }} ON {{.ResourceType}} {{if .Keyspace }}{{quote .Keyspace}}{{end}}{{if and .Keyspace .Identifier}}.{{end}}{{if .Identifier}}{{identifier .}}{{end}} OF {{quote .RoleName}}`
 )
 
+var grantTemplateFuncs = template.FuncMap{"quote": QuoteIdentifier, "identifier": Grant.quotedIdentifier}
+
+// functionArgumentTypes matches the argument types of a function signature, e.g. "int, map<text, int>".
+var functionArgumentTypes = regexp.MustCompile(`^[A-Za-z0-9_<>, ]*$`)
+
 var (
-	templateDelete, _ = template.New("deleteGrant").Parse(deleteGrantTemplate)
-	templateCreate, _ = template.New("createGrant").Parse(createGrantTemplate)
-	templateRead, _   = template.New("readGrant").Parse(readGrantTemplate)
+	templateDelete = template.Must(template.New("deleteGrant").Funcs(grantTemplateFuncs).Parse(deleteGrantTemplate))
+	templateCreate = template.Must(template.New("createGrant").Funcs(grantTemplateFuncs).Parse(createGrantTemplate))
+	templateRead   = template.Must(template.New("readGrant").Funcs(grantTemplateFuncs).Parse(readGrantTemplate))
 )
 
 type Grant struct {
@@ -50,7 +60,7 @@ func (c *Cluster) CreateGrant(grant Grant) error {
 	queryStr := queryBuffer.String()
 	log.Printf("Executing CreateGrant query: %s", queryStr)
 
-	return c.Session.Query(queryStr).Exec()
+	return c.execIdempotent(queryStr)
 }
 
 func (c *Cluster) DeleteGrant(grant Grant) error {
@@ -62,7 +72,19 @@ func (c *Cluster) DeleteGrant(grant Grant) error {
 	queryStr := queryBuffer.String()
 	log.Printf("Executing DeleteGrant query: %s", queryStr)
 
-	return c.Session.Query(queryStr).Exec()
+	return c.execIdempotent(queryStr)
+}
+
+// DeleteGrantPermissions revokes each of permissions on the resource of grant with its own REVOKE,
+// instead of the privilege of grant. Other permissions of the role on the resource stay in place.
+func (c *Cluster) DeleteGrantPermissions(grant Grant, permissions []string) error {
+	for _, permission := range permissions {
+		grant.Privilege = permission
+		if err := c.DeleteGrant(grant); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *Cluster) GetGrantPermissions(grant Grant) (permissions []string, err error) {
@@ -77,9 +99,13 @@ func (c *Cluster) GetGrantPermissions(grant Grant) (permissions []string, err er
 		return
 	}
 	for _, grantPerm := range grantPerms {
+		// LIST on a function also returns the permissions on all functions of its keyspace
+		if strings.EqualFold(grant.ResourceType, "FUNCTION") && !strings.HasPrefix(grantPerm.Resource, "<function ") {
+			continue
+		}
 		permissions = append(permissions, grantPerm.Permission)
 	}
-	return
+	return normalizePermissions(permissions), nil
 }
 
 func (c *Cluster) ListGrant(grant Grant) ([]Permission, bool, error) {
@@ -91,7 +117,9 @@ func (c *Cluster) ListGrant(grant Grant) ([]Permission, bool, error) {
 	queryStr := queryBuffer.String()
 	log.Printf("Executing ReadGrant query: %s", queryStr)
 
-	iter := c.Session.Query(queryStr).Iter()
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query(queryStr).IterContext(ctx)
 
 	var permissions []Permission
 	var p Permission
@@ -126,14 +154,135 @@ func (c *Cluster) GetRolePermissions(grant Grant) (permissions []string, err err
 	queryStr := fmt.Sprintf("SELECT permissions FROM %s.role_permissions WHERE role = ? AND resource = ? LIMIT 1", c.SystemAuthKeyspaceName)
 	log.Printf("Executing ReadGrant query: %s", queryStr)
 
-	err = c.Session.Query(queryStr, grant.RoleName, resourceName).Scan(&permissions)
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	err = c.ReadSession().Query(queryStr, grant.RoleName, resourceName).ScanContext(ctx, &permissions)
 	if errors.Is(err, gocql.ErrNotFound) {
 		// No permissions are found - returning an empty slice, not an error
 		return []string{}, nil
 	}
-	return
+	if err != nil {
+		return nil, err
+	}
+	return normalizePermissions(permissions), nil
+}
+
+// CrossCheckGrantPermissions reads the permissions of the role on the resource of grant from both
+// role_permissions and LIST ALL PERMISSIONS. The LIST view also includes permissions inherited from
+// parent roles and granted on enclosing resources, and it is served through the permissions cache,
+// so the two differing can explain why a grant behaves unexpectedly. The privilege of grant is
+// ignored.
+func (c *Cluster) CrossCheckGrantPermissions(grant Grant) (stored, listed []string, err error) {
+	stored, err = c.GetRolePermissions(grant)
+	if err != nil {
+		return nil, nil, err
+	}
+	grant.Privilege = "ALL PERMISSIONS"
+	listedPerms, _, err := c.ListGrant(grant)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, listedPerm := range listedPerms {
+		listed = append(listed, strings.ToUpper(listedPerm.Permission))
+	}
+	return normalizePermissions(stored), normalizePermissions(listed), nil
+}
+
+// normalizePermissions sorts and deduplicates permissions in place so they compare equal
+// regardless of the order or duplicates the server returns them in.
+func normalizePermissions(permissions []string) []string {
+	if permissions == nil {
+		return []string{}
+	}
+	slices.Sort(permissions)
+	return slices.Compact(permissions)
+}
+
+// GrantLookup is the result of reading the permissions of a grant once.
+type GrantLookup struct {
+	// Permissions are the permissions the role holds on the resource of the grant, as returned
+	// by GetGrantPermissions.
+	Permissions []string
+	// Exists reports whether Permissions include every permission of the grant.
+	Exists bool
+}
+
+// LookupGrant reads the permissions of the role on the resource of grant with a single query, and
+// reports whether they include the grant. Callers that check whether a grant exists and then
+// need its permissions, e.g. to adopt it, can use the result for both instead of reading twice.
+func (c *Cluster) LookupGrant(grant Grant) (GrantLookup, error) {
+	permissions, err := c.GetGrantPermissions(grant)
+	if err != nil {
+		return GrantLookup{}, err
+	}
+	return GrantLookup{
+		Permissions: permissions,
+		Exists:      containsAll(permissions, grant.GetExpandedPermissions()),
+	}, nil
 }
 
+// GrantExists reports whether all permissions of the grant are already held by the role on the
+// resource, so the grant can be adopted without issuing a GRANT statement.
+func (c *Cluster) GrantExists(grant Grant) (bool, error) {
+	lookup, err := c.LookupGrant(grant)
+	return lookup.Exists, err
+}
+
+// parseFunctionSignature splits the identifier of a grant on a FUNCTION, e.g. "avg_state(int, int)",
+// into the name of the function and its argument types.
+func parseFunctionSignature(signature string) (name string, argumentTypes []string, err error) {
+	name, arguments, ok := strings.Cut(signature, "(")
+	arguments, closed := strings.CutSuffix(strings.TrimSpace(arguments), ")")
+	name = strings.TrimSpace(name)
+	if !ok || !closed || name == "" || !functionArgumentTypes.MatchString(arguments) {
+		return "", nil, fmt.Errorf("invalid function signature %q, expected e.g. avg_state(int, int)", signature)
+	}
+	depth, start := 0, 0
+	for i, r := range arguments {
+		switch r {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				argumentTypes = append(argumentTypes, strings.TrimSpace(arguments[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(arguments[start:]); last != "" || len(argumentTypes) > 0 {
+		argumentTypes = append(argumentTypes, last)
+	}
+	if depth != 0 || slices.Contains(argumentTypes, "") {
+		return "", nil, fmt.Errorf("invalid function signature %q, expected e.g. avg_state(int, int)", signature)
+	}
+	return name, argumentTypes, nil
+}
+
+// ValidateFunctionSignature returns an error when signature is not the signature of a function a
+// grant on a FUNCTION can refer to, e.g. avg_state(int, int).
+func ValidateFunctionSignature(signature string) error {
+	_, _, err := parseFunctionSignature(signature)
+	return err
+}
+
+// quotedIdentifier returns the identifier of the grant as the grant statements refer to it: a
+// quoted name, or the quoted name of a function followed by its argument types.
+func (g Grant) quotedIdentifier() (string, error) {
+	if !strings.EqualFold(g.ResourceType, "FUNCTION") {
+		return QuoteIdentifier(g.Identifier), nil
+	}
+	name, argumentTypes, err := parseFunctionSignature(g.Identifier)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s)", QuoteIdentifier(name), strings.Join(argumentTypes, ", ")), nil
+}
+
+// getResourceName returns the name of the resource of grant in role_permissions. For a single
+// FUNCTION it is the name of the function without its argument types, which role_permissions
+// encodes in an internal form; its permissions are read with LIST instead.
 func getResourceName(grant Grant) string {
 	switch strings.ToUpper(grant.ResourceType) {
 	case "ALL KEYSPACES":
@@ -146,6 +295,13 @@ func getResourceName(grant Grant) string {
 		return "roles"
 	case "ROLE":
 		return fmt.Sprintf("roles/%s", grant.Keyspace)
+	case "ALL FUNCTIONS":
+		return "functions"
+	case "ALL FUNCTIONS IN KEYSPACE":
+		return fmt.Sprintf("functions/%s", grant.Keyspace)
+	case "FUNCTION":
+		name, _, _ := strings.Cut(grant.Identifier, "(")
+		return fmt.Sprintf("functions/%s/%s", grant.Keyspace, strings.TrimSpace(name))
 	default:
 		return ""
 	}
@@ -161,9 +317,45 @@ func (g Grant) GetExpandedPermissions() []string {
 		return []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "MODIFY", "SELECT"}
 	case "TABLE":
 		return []string{"ALTER", "AUTHORIZE", "DROP", "MODIFY", "SELECT"}
-	case "ALL ROLES", "ROLE":
+	case "ALL ROLES":
+		return []string{"ALTER", "AUTHORIZE", "CREATE", "DESCRIBE", "DROP"}
+	case "ROLE":
 		return []string{"ALTER", "AUTHORIZE", "DROP"}
+	case "ALL FUNCTIONS", "ALL FUNCTIONS IN KEYSPACE":
+		return []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "EXECUTE"}
+	case "FUNCTION":
+		return []string{"ALTER", "AUTHORIZE", "DROP", "EXECUTE"}
 	default:
 		return []string{origPerm}
 	}
 }
+
+// OwnPermissions returns the permissions of recorded that the privilege of the grant covers.
+// The permissions recorded for a grant include those the role holds on the resource through other
+// grants, which must not be revoked along with it.
+func (g Grant) OwnPermissions(recorded []string) []string {
+	expanded := g.GetExpandedPermissions()
+	own := []string{}
+	for _, permission := range recorded {
+		if permission = strings.ToUpper(permission); slices.Contains(expanded, permission) {
+			own = append(own, permission)
+		}
+	}
+	return normalizePermissions(own)
+}
+
+// ValidatePrivilege returns an error when the privilege of the grant does not apply to its
+// resource, e.g. SELECT on ALL ROLES or CREATE on a table. ALL PERMISSIONS applies to every
+// resource.
+func (g Grant) ValidatePrivilege() error {
+	privilege := strings.ToUpper(g.Privilege)
+	if privilege == "ALL PERMISSIONS" {
+		return nil
+	}
+	applicable := Grant{Privilege: "ALL PERMISSIONS", ResourceType: g.ResourceType}.GetExpandedPermissions()
+	if !slices.Contains(applicable, privilege) {
+		return fmt.Errorf("the %s privilege does not apply to %s, which supports %s",
+			privilege, strings.ToUpper(g.ResourceType), strings.Join(applicable, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// grantTargetPollInterval is the delay between checks while waiting for a grant target.
+const grantTargetPollInterval = 500 * time.Millisecond
+
+var ErrGrantTargetNotFound = errors.New("grant target does not exist")
+
+// GrantTargetExists reports whether the keyspace or table a grant applies to exists. Grants on
+// other resources are always considered to exist.
+func (c *Cluster) GrantTargetExists(grant Grant) (bool, error) {
+	var query string
+	var values []any
+	switch strings.ToUpper(grant.ResourceType) {
+	case "KEYSPACE":
+		query = "SELECT keyspace_name FROM system_schema.keyspaces WHERE keyspace_name = ?"
+		values = []any{grant.Keyspace}
+	case "TABLE":
+		query = "SELECT table_name FROM system_schema.tables WHERE keyspace_name = ? AND table_name = ?"
+		values = []any{grant.Keyspace, grant.Identifier}
+	default:
+		return true, nil
+	}
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	var name string
+	if err := c.ReadSession().Query(query, values...).ScanContext(ctx, &name); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// WaitForGrantTarget waits up to timeout for the keyspace or table a grant applies to to exist.
+// This smooths over a target that is created concurrently in the same apply, or that has not yet
+// propagated to the node being queried. The error wraps ErrGrantTargetNotFound on timeout.
+func (c *Cluster) WaitForGrantTarget(grant Grant, timeout time.Duration) error {
+	err := pollUntil(timeout, grantTargetPollInterval, func() (bool, error) {
+		return c.GrantTargetExists(grant)
+	})
+	if errors.Is(err, errPollTimeout) {
+		return fmt.Errorf("%w: %s", ErrGrantTargetNotFound, getResourceName(grant))
+	}
+	return err
+}
+
+var errPollTimeout = errors.New("timed out")
+
+// pollUntil calls check every interval until it returns true or an error, or timeout elapses.
+// check is always called at least once.
+func pollUntil(timeout, interval time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return errPollTimeout
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollUntil(t *testing.T) {
+	calls := 0
+	err := pollUntil(time.Second, time.Millisecond, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// A check that never succeeds times out
+	err = pollUntil(10*time.Millisecond, time.Millisecond, func() (bool, error) { return false, nil })
+	assert.ErrorIs(t, err, errPollTimeout)
+
+	// Errors stop polling immediately
+	calls = 0
+	boom := errors.New("boom")
+	err = pollUntil(time.Second, time.Millisecond, func() (bool, error) {
+		calls++
+		return false, boom
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWaitForGrantTarget(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	setupTestKSAndTable(t, cluster)
+	require.NoError(t, cluster.CreateRole(Role{Role: "late_reader"}))
+
+	grant := Grant{
+		RoleName:     "late_reader",
+		Privilege:    "SELECT",
+		ResourceType: "TABLE",
+		Keyspace:     "cycling",
+		Identifier:   "late_table",
+	}
+
+	// The table appears shortly after the first check
+	created := make(chan error, 1)
+	go func() {
+		time.Sleep(2 * time.Second)
+		created <- cluster.Session.Query(`CREATE TABLE IF NOT EXISTS cycling.late_table (id UUID PRIMARY KEY)`).Exec()
+	}()
+	exists, err := cluster.GrantTargetExists(grant)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, cluster.WaitForGrantTarget(grant, 30*time.Second))
+	require.NoError(t, <-created)
+	require.NoError(t, cluster.CreateGrant(grant))
+
+	// A target that never appears times out
+	missing := grant
+	missing.Identifier = "never_created"
+	assert.ErrorIs(t, cluster.WaitForGrantTarget(missing, time.Second), ErrGrantTargetNotFound)
+}
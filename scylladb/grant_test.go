@@ -3,9 +3,11 @@
 package scylladb
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -132,3 +134,261 @@ func newTestClusterWithTableAndRole(t *testing.T) *Cluster {
 
 	return cluster
 }
+
+func TestGrantExists(t *testing.T) {
+	cluster := newTestClusterWithTableAndRole(t)
+	defer cluster.Session.Close()
+
+	grant := Grant{
+		RoleName:     "testRole",
+		Privilege:    "MODIFY",
+		ResourceType: "KEYSPACE",
+		Keyspace:     "cycling",
+	}
+	exists, err := cluster.GrantExists(grant)
+	if err != nil {
+		t.Fatalf("failed to check grant: %s", err)
+	}
+	assert.False(t, exists)
+
+	if err := cluster.CreateGrant(grant); err != nil {
+		t.Fatalf("failed to create grant: %s", err)
+	}
+	exists, err = cluster.GrantExists(grant)
+	if err != nil {
+		t.Fatalf("failed to check grant: %s", err)
+	}
+	assert.True(t, exists)
+
+	// ALL PERMISSIONS only exists once every expanded permission is held.
+	all := grant
+	all.Privilege = "ALL PERMISSIONS"
+	exists, err = cluster.GrantExists(all)
+	if err != nil {
+		t.Fatalf("failed to check grant: %s", err)
+	}
+	assert.False(t, exists)
+}
+
+// TestLookupGrant verifies that a lookup reads the permissions of a grant and whether it exists
+// with a single query, for grants read from role_permissions and with LIST PERMISSIONS alike.
+func TestLookupGrant(t *testing.T) {
+	cluster := newTestClusterWithTableAndRole(t)
+	defer cluster.Session.Close()
+
+	keyspaceGrant := Grant{RoleName: "testRole", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"}
+	rolesGrant := Grant{RoleName: "testRole", Privilege: "DESCRIBE", ResourceType: "ALL ROLES"}
+	for _, grant := range []Grant{keyspaceGrant, rolesGrant} {
+		if err := cluster.CreateGrant(grant); err != nil {
+			t.Fatalf("failed to create grant: %s", err)
+		}
+
+		before := cluster.Metrics().Queries
+		lookup, err := cluster.LookupGrant(grant)
+		if err != nil {
+			t.Fatalf("failed to look up grant: %s", err)
+		}
+		assert.Equal(t, int64(1), cluster.Metrics().Queries-before, "queries for a %s grant", grant.ResourceType)
+		assert.True(t, lookup.Exists)
+		assert.Contains(t, lookup.Permissions, grant.Privilege)
+	}
+}
+
+func TestNormalizePermissions(t *testing.T) {
+	assert.Equal(t,
+		[]string{"ALTER", "MODIFY", "SELECT"},
+		normalizePermissions([]string{"SELECT", "ALTER", "SELECT", "MODIFY", "ALTER"}),
+	)
+	assert.Equal(t, []string{}, normalizePermissions(nil))
+}
+
+func TestGetExpandedPermissions(t *testing.T) {
+	tests := []struct {
+		privilege    string
+		resourceType string
+		want         []string
+	}{
+		{"ALL PERMISSIONS", "ALL KEYSPACES", []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "MODIFY", "SELECT"}},
+		{"ALL PERMISSIONS", "KEYSPACE", []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "MODIFY", "SELECT"}},
+		{"ALL PERMISSIONS", "TABLE", []string{"ALTER", "AUTHORIZE", "DROP", "MODIFY", "SELECT"}},
+		{"ALL PERMISSIONS", "ALL ROLES", []string{"ALTER", "AUTHORIZE", "CREATE", "DESCRIBE", "DROP"}},
+		{"ALL PERMISSIONS", "ROLE", []string{"ALTER", "AUTHORIZE", "DROP"}},
+		{"ALL PERMISSIONS", "ALL FUNCTIONS", []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "EXECUTE"}},
+		{"ALL PERMISSIONS", "ALL FUNCTIONS IN KEYSPACE", []string{"ALTER", "AUTHORIZE", "CREATE", "DROP", "EXECUTE"}},
+		{"ALL PERMISSIONS", "FUNCTION", []string{"ALTER", "AUTHORIZE", "DROP", "EXECUTE"}},
+		{"all permissions", "table", []string{"ALTER", "AUTHORIZE", "DROP", "MODIFY", "SELECT"}},
+		{"select", "TABLE", []string{"SELECT"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.privilege+" ON "+tc.resourceType, func(t *testing.T) {
+			grant := Grant{Privilege: tc.privilege, ResourceType: tc.resourceType}
+			assert.Equal(t, tc.want, grant.GetExpandedPermissions())
+		})
+	}
+}
+
+func TestGrantValidatePrivilege(t *testing.T) {
+	for _, privilege := range []string{"ALL PERMISSIONS", "CREATE", "ALTER", "DROP", "authorize", "DESCRIBE"} {
+		assert.NoError(t, Grant{Privilege: privilege, ResourceType: "ALL ROLES"}.ValidatePrivilege(), privilege)
+	}
+	assert.ErrorContains(t, Grant{Privilege: "SELECT", ResourceType: "ALL ROLES"}.ValidatePrivilege(),
+		"the SELECT privilege does not apply to ALL ROLES")
+	assert.Error(t, Grant{Privilege: "MODIFY", ResourceType: "all roles"}.ValidatePrivilege())
+	assert.Error(t, Grant{Privilege: "CREATE", ResourceType: "TABLE"}.ValidatePrivilege())
+	assert.NoError(t, Grant{Privilege: "SELECT", ResourceType: "KEYSPACE"}.ValidatePrivilege())
+	assert.NoError(t, Grant{Privilege: "EXECUTE", ResourceType: "FUNCTION"}.ValidatePrivilege())
+	assert.Error(t, Grant{Privilege: "EXECUTE", ResourceType: "KEYSPACE"}.ValidatePrivilege())
+	assert.Error(t, Grant{Privilege: "CREATE", ResourceType: "FUNCTION"}.ValidatePrivilege())
+}
+
+func TestGrantTemplateAllRoles(t *testing.T) {
+	grant := Grant{RoleName: "role_admin", Privilege: "AUTHORIZE", ResourceType: "ALL ROLES"}
+	var query bytes.Buffer
+	require.NoError(t, templateCreate.Execute(&query, grant))
+	assert.Contains(t, query.String(), `GRANT AUTHORIZE ON ALL ROLES `)
+	assert.Equal(t, "roles", getResourceName(grant))
+}
+
+func TestGrantTemplateQuoting(t *testing.T) {
+	grant := Grant{RoleName: "My_Role", Privilege: "SELECT", ResourceType: "TABLE", Keyspace: "cycling", Identifier: `odd"name`}
+	var query bytes.Buffer
+	require.NoError(t, templateCreate.Execute(&query, grant))
+	assert.Equal(t, `GRANT SELECT ON TABLE "cycling"."odd""name" TO "My_Role"`, query.String())
+
+	query.Reset()
+	require.NoError(t, templateRead.Execute(&query, Grant{RoleName: "select", Privilege: "ALL PERMISSIONS", ResourceType: "ALL KEYSPACES"}))
+	assert.Equal(t, `LIST ALL PERMISSIONS ON ALL KEYSPACES  OF "select"`, query.String())
+}
+
+func TestGrantTemplateFunctions(t *testing.T) {
+	tests := []struct {
+		grant        Grant
+		query        string
+		resourceName string
+	}{
+		{
+			Grant{RoleName: "app", Privilege: "EXECUTE", ResourceType: "FUNCTION", Keyspace: "cycling", Identifier: "avg_state(int, map<text, int>)"},
+			`GRANT EXECUTE ON FUNCTION "cycling"."avg_state"(int, map<text, int>) TO "app"`,
+			"functions/cycling/avg_state",
+		},
+		{
+			Grant{RoleName: "app", Privilege: "EXECUTE", ResourceType: "FUNCTION", Keyspace: "cycling", Identifier: "now_ms()"},
+			`GRANT EXECUTE ON FUNCTION "cycling"."now_ms"() TO "app"`,
+			"functions/cycling/now_ms",
+		},
+		{
+			Grant{RoleName: "app", Privilege: "EXECUTE", ResourceType: "ALL FUNCTIONS IN KEYSPACE", Keyspace: "cycling"},
+			`GRANT EXECUTE ON ALL FUNCTIONS IN KEYSPACE "cycling" TO "app"`,
+			"functions/cycling",
+		},
+		{
+			Grant{RoleName: "app", Privilege: "EXECUTE", ResourceType: "ALL FUNCTIONS"},
+			`GRANT EXECUTE ON ALL FUNCTIONS  TO "app"`,
+			"functions",
+		},
+	}
+	for _, tc := range tests {
+		var query bytes.Buffer
+		require.NoError(t, templateCreate.Execute(&query, tc.grant))
+		assert.Equal(t, tc.query, query.String())
+		assert.Equal(t, tc.resourceName, getResourceName(tc.grant))
+	}
+
+	for _, signature := range []string{"avg_state", "avg_state(int", "avg_state(int);DROP KEYSPACE cycling;(", "(int)", "avg_state(int,)", "avg_state(map<text, int)"} {
+		assert.Error(t, ValidateFunctionSignature(signature), signature)
+		var query bytes.Buffer
+		assert.Error(t, templateCreate.Execute(&query, Grant{RoleName: "app", Privilege: "EXECUTE", ResourceType: "FUNCTION", Keyspace: "cycling", Identifier: signature}), signature)
+	}
+}
+
+func TestGrantOnFunction(t *testing.T) {
+	cluster := newTestClusterWithTableAndRole(t)
+	defer cluster.Session.Close()
+
+	createFunctionQuery := `CREATE FUNCTION cycling.double_it(input int) RETURNS NULL ON NULL INPUT RETURNS int LANGUAGE lua AS 'return input * 2'`
+	require.NoError(t, cluster.Session.Query(createFunctionQuery).Exec())
+
+	grant := Grant{RoleName: "testRole", Privilege: "EXECUTE", ResourceType: "FUNCTION", Keyspace: "cycling", Identifier: "double_it(int)"}
+	require.NoError(t, cluster.CreateGrant(grant))
+	// A permission on all functions of the keyspace is not a permission of the grant
+	require.NoError(t, cluster.CreateGrant(Grant{RoleName: "testRole", Privilege: "ALTER", ResourceType: "ALL FUNCTIONS IN KEYSPACE", Keyspace: "cycling"}))
+
+	permissions, err := cluster.GetGrantPermissions(grant)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EXECUTE"}, permissions)
+
+	require.NoError(t, cluster.DeleteGrant(grant))
+	permissions, err = cluster.GetGrantPermissions(grant)
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+}
+
+// TestGrantsOnQuotedRoleNames verifies that roles whose names are mixed-case or reserved words
+// can be created, granted permissions, altered, and dropped.
+func TestGrantsOnQuotedRoleNames(t *testing.T) {
+	cluster := newTestClusterWithTableAndRole(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"My_Role", "select"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+		role, err := cluster.GetRole(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, role.Role)
+
+		grant := Grant{RoleName: name, Privilege: "SELECT", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}
+		require.NoError(t, cluster.CreateGrant(grant))
+		exists, err := cluster.GrantExists(grant)
+		require.NoError(t, err)
+		assert.True(t, exists, "grant to %s", name)
+
+		require.NoError(t, cluster.UpdateRole(Role{Role: name, CanLogin: true}))
+		require.NoError(t, cluster.DeleteGrant(grant))
+		exists, err = cluster.GrantExists(grant)
+		require.NoError(t, err)
+		assert.False(t, exists, "grant to %s", name)
+		require.NoError(t, cluster.DeleteRole(Role{Role: name}))
+	}
+}
+
+func TestCrossCheckGrantPermissions(t *testing.T) {
+	cluster := newTestClusterWithTableAndRole(t)
+	defer cluster.Session.Close()
+
+	tableGrant := Grant{
+		RoleName:     "testRole",
+		Privilege:    "MODIFY",
+		ResourceType: "TABLE",
+		Keyspace:     "cycling",
+		Identifier:   "cyclist_name",
+	}
+	require.NoError(t, cluster.CreateGrant(tableGrant))
+
+	// Both sources agree while only the table grant exists
+	stored, listed, err := cluster.CrossCheckGrantPermissions(tableGrant)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MODIFY"}, stored)
+	assert.Equal(t, []string{"MODIFY"}, listed)
+
+	// A grant on the enclosing keyspace only shows up in the LIST view of the table
+	require.NoError(t, cluster.CreateGrant(Grant{
+		RoleName:     "testRole",
+		Privilege:    "SELECT",
+		ResourceType: "KEYSPACE",
+		Keyspace:     "cycling",
+	}))
+	stored, listed, err = cluster.CrossCheckGrantPermissions(tableGrant)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MODIFY"}, stored)
+	assert.Equal(t, []string{"MODIFY", "SELECT"}, listed)
+}
+
+func TestGrantOwnPermissions(t *testing.T) {
+	alter := Grant{Privilege: "ALTER", ResourceType: "KEYSPACE", Keyspace: "cycling"}
+	// SELECT was recorded because another grant gives it, so it is not the ALTER grant's to revoke
+	assert.Equal(t, []string{"ALTER"}, alter.OwnPermissions([]string{"SELECT", "ALTER"}))
+	assert.Equal(t, []string{}, alter.OwnPermissions([]string{"SELECT"}))
+
+	all := Grant{Privilege: "ALL PERMISSIONS", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}
+	assert.Equal(t, []string{"DROP", "SELECT"}, all.OwnPermissions([]string{"select", "DROP", "SELECT"}))
+	assert.Equal(t, []string{}, all.OwnPermissions(nil))
+}
@@ -0,0 +1,57 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"log"
+	"slices"
+	"time"
+)
+
+// DefaultGrantVerifyAttempts is how many times ReadGrantPermissions reads the permissions of a
+// new grant before giving up on seeing it.
+const DefaultGrantVerifyAttempts = 5
+
+// grantVerifyBackoff is the delay before the first retry; it doubles for each further retry.
+const grantVerifyBackoff = 200 * time.Millisecond
+
+// ReadGrantPermissions reads the permissions of a grant that was just created, like
+// GetGrantPermissions. Nodes with a nonzero permissions_validity may serve the permissions from
+// their cache for a while after the GRANT, so the read is retried with a short, doubling backoff
+// up to GrantVerifyAttempts times until every permission of the grant shows up. visible reports
+// whether they did; when they did not, the permissions of the last read are returned.
+func (c *Cluster) ReadGrantPermissions(grant Grant) (permissions []string, visible bool, err error) {
+	return awaitPermissions(grant.GetExpandedPermissions(), c.GrantVerifyAttempts, grantVerifyBackoff, time.Sleep, func() ([]string, error) {
+		return c.GetGrantPermissions(grant)
+	})
+}
+
+// awaitPermissions calls read until its result contains all of expected, at most attempts times,
+// sleeping backoff before the first retry and twice as long before each further one. read is
+// always called at least once.
+func awaitPermissions(expected []string, attempts int, backoff time.Duration, sleep func(time.Duration), read func() ([]string, error)) ([]string, bool, error) {
+	for attempt := 1; ; attempt++ {
+		permissions, err := read()
+		if err != nil {
+			return nil, false, err
+		}
+		if containsAll(permissions, expected) {
+			return permissions, true, nil
+		}
+		if attempt >= attempts {
+			return permissions, false, nil
+		}
+		log.Printf("Permissions %v do not include %v yet, retrying in %s", permissions, expected, backoff)
+		sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func containsAll(values, wanted []string) bool {
+	for _, value := range wanted {
+		if !slices.Contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwaitPermissions(t *testing.T) {
+	// The permission only becomes visible on the third read, as with a lagging permissions cache
+	var sleeps []time.Duration
+	sleep := func(d time.Duration) { sleeps = append(sleeps, d) }
+	reads := 0
+	delayed := func() ([]string, error) {
+		reads++
+		if reads < 3 {
+			return []string{"ALTER"}, nil
+		}
+		return []string{"ALTER", "SELECT"}, nil
+	}
+
+	permissions, visible, err := awaitPermissions([]string{"SELECT"}, 5, 10*time.Millisecond, sleep, delayed)
+	assert.NoError(t, err)
+	assert.True(t, visible)
+	assert.Equal(t, []string{"ALTER", "SELECT"}, permissions)
+	assert.Equal(t, 3, reads)
+	assert.Equal(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}, sleeps)
+
+	// The budget runs out before the permission shows up
+	sleeps, reads = nil, 0
+	permissions, visible, err = awaitPermissions([]string{"SELECT"}, 2, 10*time.Millisecond, sleep, delayed)
+	assert.NoError(t, err)
+	assert.False(t, visible)
+	assert.Equal(t, []string{"ALTER"}, permissions)
+	assert.Equal(t, 2, reads)
+	assert.Len(t, sleeps, 1)
+
+	// A single attempt never sleeps
+	sleeps, reads = nil, 0
+	_, visible, err = awaitPermissions([]string{"SELECT"}, 1, 10*time.Millisecond, sleep, delayed)
+	assert.NoError(t, err)
+	assert.False(t, visible)
+	assert.Empty(t, sleeps)
+
+	// Errors stop retrying immediately
+	boom := errors.New("boom")
+	reads = 0
+	_, _, err = awaitPermissions([]string{"SELECT"}, 5, 10*time.Millisecond, sleep, func() ([]string, error) {
+		reads++
+		return nil, boom
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, reads)
+}
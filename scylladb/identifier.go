@@ -0,0 +1,12 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import "strings"
+
+// QuoteIdentifier returns name as a double-quoted CQL identifier, so that mixed-case names and
+// reserved words are used verbatim instead of being lowercased or rejected. Double quotes within
+// name are escaped by doubling them.
+func QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,16 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, `"cycling"`, QuoteIdentifier("cycling"))
+	assert.Equal(t, `"Cycling"`, QuoteIdentifier("Cycling"))
+	assert.Equal(t, `"select"`, QuoteIdentifier("select"))
+	assert.Equal(t, `"say ""hi"""`, QuoteIdentifier(`say "hi"`))
+}
@@ -0,0 +1,60 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// idlePingAttempts bounds how many pings are sent after an idle period. Each failed ping makes
+// gocql drop a connection that was reset while idle, so a few attempts clear out several of them.
+const idlePingAttempts = 3
+
+// idleTracker records when the session was last used.
+type idleTracker struct {
+	maxIdle time.Duration
+	now     func() time.Time
+
+	mu       sync.Mutex
+	lastUsed time.Time
+}
+
+// expired records a use of the session and reports whether it had been idle for longer than
+// maxIdle. Only one of several concurrent callers sees an expired session.
+func (t *idleTracker) expired() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	idle := !t.lastUsed.IsZero() && now.Sub(t.lastUsed) > t.maxIdle
+	t.lastUsed = now
+	return idle
+}
+
+// SetMaxIdleTime makes the cluster ping the session before the first query after it was idle for
+// longer than maxIdle. Proxies and load balancers tend to reset idle connections silently, which
+// would otherwise fail the next query. gocql has no idle timeout of its own, and its
+// SocketKeepalive is not applied with a proxy. Zero disables the check.
+func (c *Cluster) SetMaxIdleTime(maxIdle time.Duration) {
+	if maxIdle <= 0 {
+		c.idle = nil
+		return
+	}
+	c.idle = &idleTracker{maxIdle: maxIdle, now: time.Now}
+}
+
+// pingIfIdle pings the session when it has been idle for too long, so that connections reset in
+// the meantime are dropped and reopened by gocql before they are used for a real query.
+func (c *Cluster) pingIfIdle() {
+	if c.idle == nil || c.Session == nil || !c.idle.expired() {
+		return
+	}
+	for attempt := 1; attempt <= idlePingAttempts; attempt++ {
+		if _, _, err := c.ping(); err != nil {
+			log.Printf("Ping %d of %d after the session was idle failed: %s", attempt, idlePingAttempts, err)
+			continue
+		}
+		return
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for idleTracker.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestIdleTrackerExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tracker := &idleTracker{maxIdle: time.Minute, now: clock.Now}
+
+	// The first use is never idle
+	assert.False(t, tracker.expired())
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.False(t, tracker.expired())
+
+	clock.now = clock.now.Add(time.Minute + time.Second)
+	assert.True(t, tracker.expired())
+	// The use that found the session idle resets the idle period
+	assert.False(t, tracker.expired())
+}
+
+func TestSetMaxIdleTime(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+
+	cluster.SetMaxIdleTime(5 * time.Minute)
+	assert.Equal(t, "5m0s", cluster.EffectiveConfig()["max_idle_time"])
+
+	cluster.SetMaxIdleTime(0)
+	assert.Nil(t, cluster.idle)
+	assert.NotContains(t, cluster.EffectiveConfig(), "max_idle_time")
+}
+
+// pingCounter counts the pings run on a session.
+type pingCounter struct {
+	pings atomic.Int32
+}
+
+func (o *pingCounter) ObserveQuery(_ context.Context, q gocql.ObservedQuery) {
+	if strings.Contains(q.Statement, "release_version") {
+		o.pings.Add(1)
+	}
+}
+
+func TestPingIfIdle(t *testing.T) {
+	host := testutil.NewTestContainer(t)
+	cluster, err := NewClusterConfig([]string{host})
+	require.NoError(t, err)
+	cluster.SetSystemAuthKeyspace("system")
+	cluster.SetUserPasswordAuth("cassandra", "cassandra")
+	observer := &pingCounter{}
+	cluster.Cluster.QueryObserver = observer
+	require.NoError(t, cluster.CreateSession())
+	defer cluster.Session.Close()
+
+	clock := &fakeClock{now: time.Now()}
+	cluster.SetMaxIdleTime(time.Minute)
+	cluster.idle.now = clock.Now
+
+	// Regular use does not ping
+	_, err = cluster.GetRole("cassandra")
+	require.NoError(t, err)
+	clock.now = clock.now.Add(30 * time.Second)
+	_, err = cluster.GetRole("cassandra")
+	require.NoError(t, err)
+	assert.Zero(t, observer.pings.Load())
+
+	// The first query after an idle period is preceded by a ping
+	clock.now = clock.now.Add(2 * time.Minute)
+	_, err = cluster.GetRole("cassandra")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, observer.pings.Load())
+
+	_, err = cluster.GetRole("cassandra")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, observer.pings.Load())
+}
@@ -3,29 +3,379 @@
 package scylladb
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
 )
 
+const NetworkTopologyStrategy = "NetworkTopologyStrategy"
+
+// ErrUnknownDatacenter is returned when a replication map references a datacenter that is not
+// part of the cluster.
+var ErrUnknownDatacenter = errors.New("unknown datacenter")
+
+// ErrKeyspaceNotFound is returned by GetKeyspace when the keyspace does not exist.
+var ErrKeyspaceNotFound = errors.New("keyspace not found")
+
+// ErrKeyspaceAlreadyExists is returned by CreateKeyspaceStrict when the keyspace already exists.
+var ErrKeyspaceAlreadyExists = errors.New("keyspace already exists")
+
+// systemKeyspaces are the keyspaces ScyllaDB creates for its own use.
+var systemKeyspaces = []string{
+	"system",
+	"system_auth",
+	"system_distributed",
+	"system_distributed_everywhere",
+	"system_replicated_keys",
+	"system_schema",
+	"system_traces",
+}
+
+// IsSystemKeyspace reports whether name is one of the keyspaces ScyllaDB uses internally.
+// Unquoted keyspace names are case-insensitive, so the comparison is too.
+func IsSystemKeyspace(name string) bool {
+	return slices.Contains(systemKeyspaces, strings.ToLower(name))
+}
+
 type Keyspace struct {
 	Name              string
 	ReplicationClass  string
 	ReplicationFactor int
-	DurableWrites     bool
+	// DatacenterReplication maps datacenter names to their replication factor. It is only used
+	// with NetworkTopologyStrategy and takes precedence over ReplicationFactor when set.
+	DatacenterReplication map[string]int
+	DurableWrites         bool
+}
+
+// replication returns the CQL replication map for the keyspace. The class and the datacenter
+// names are escaped as string literals.
+func (ks Keyspace) replication() string {
+	if ks.ReplicationClass != NetworkTopologyStrategy || len(ks.DatacenterReplication) == 0 {
+		return fmt.Sprintf(`{'class': %s, 'replication_factor': %d}`, quoteLiteral(ks.ReplicationClass), ks.ReplicationFactor)
+	}
+	// Sort the datacenters so the generated query is deterministic.
+	options := []string{"'class': " + quoteLiteral(ks.ReplicationClass)}
+	for _, dc := range slices.Sorted(maps.Keys(ks.DatacenterReplication)) {
+		options = append(options, fmt.Sprintf(`%s: %d`, quoteLiteral(dc), ks.DatacenterReplication[dc]))
+	}
+	return "{" + strings.Join(options, ", ") + "}"
 }
 
+// CreateKeyspace creates the keyspace, keeping an existing keyspace of the same name unless the
+// DDLMode is DDLModeStrict.
 func (c *Cluster) CreateKeyspace(ks Keyspace) error {
-	query := fmt.Sprintf(`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = {'class': '%s', 'replication_factor': %d} AND durable_writes = %v`,
-		ks.Name,
-		ks.ReplicationClass,
-		ks.ReplicationFactor,
+	query := fmt.Sprintf(`CREATE KEYSPACE %s%s WITH replication = %s AND durable_writes = %v`,
+		c.ifNotExists(true),
+		QuoteIdentifier(ks.Name),
+		ks.replication(),
 		ks.DurableWrites,
 	)
 	log.Printf("Executing CreateKeyspace query: %s", query)
-	return c.Session.Query(query).Exec()
+	return c.execDDL(query)
+}
+
+// CreateKeyspaceStrict creates the keyspace like CreateKeyspace, but returns
+// ErrKeyspaceAlreadyExists instead of silently keeping an existing keyspace whose settings may
+// not match ks, unless the DDLMode is DDLModeIdempotent.
+func (c *Cluster) CreateKeyspaceStrict(ks Keyspace) error {
+	query := fmt.Sprintf(`CREATE KEYSPACE %s%s WITH replication = %s AND durable_writes = %v`,
+		c.ifNotExists(false),
+		QuoteIdentifier(ks.Name),
+		ks.replication(),
+		ks.DurableWrites,
+	)
+	log.Printf("Executing CreateKeyspaceStrict query: %s", query)
+	err := c.execDDL(query)
+	var alreadyExists *gocql.RequestErrAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		return fmt.Errorf("%w: %s", ErrKeyspaceAlreadyExists, ks.Name)
+	}
+	return err
+}
+
+// GetKeyspace reads a keyspace from system_schema.keyspaces. SimpleStrategy keyspaces populate
+// ReplicationFactor and NetworkTopologyStrategy keyspaces populate DatacenterReplication, so the
+// result can be passed back to CreateKeyspace to recreate the same keyspace. The name is matched
+// case-sensitively, like the quoted name CreateKeyspace creates.
+func (c *Cluster) GetKeyspace(name string) (Keyspace, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	var durableWrites bool
+	var replication map[string]string
+	query := "SELECT durable_writes, replication FROM system_schema.keyspaces WHERE keyspace_name = ?"
+	if err := c.ReadSession().Query(query, name).ScanContext(ctx, &durableWrites, &replication); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return Keyspace{}, fmt.Errorf("%w: %s", ErrKeyspaceNotFound, name)
+		}
+		return Keyspace{}, err
+	}
+	return keyspaceFromReplication(name, replication, durableWrites)
+}
+
+// ListKeyspaces returns all keyspaces from system_schema.keyspaces, sorted by name. Unless
+// includeSystem is set, the keyspaces ScyllaDB uses internally are left out.
+func (c *Cluster) ListKeyspaces(includeSystem bool) ([]Keyspace, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query("SELECT keyspace_name, durable_writes, replication FROM system_schema.keyspaces").IterContext(ctx)
+	var keyspaces []Keyspace
+	var name string
+	var durableWrites bool
+	var replication map[string]string
+	for iter.Scan(&name, &durableWrites, &replication) {
+		if includeSystem || !IsSystemKeyspace(name) {
+			ks, err := keyspaceFromReplication(name, replication, durableWrites)
+			if err != nil {
+				_ = iter.Close()
+				return nil, err
+			}
+			keyspaces = append(keyspaces, ks)
+		}
+		replication = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(keyspaces, func(a, b Keyspace) int { return strings.Compare(a.Name, b.Name) })
+	return keyspaces, nil
+}
+
+// keyspaceFromReplication parses the replication map stored in system_schema.keyspaces.
+func keyspaceFromReplication(name string, replication map[string]string, durableWrites bool) (Keyspace, error) {
+	ks := Keyspace{
+		Name:          name,
+		DurableWrites: durableWrites,
+	}
+	// The class is stored fully qualified, e.g. org.apache.cassandra.locator.SimpleStrategy.
+	class := replication["class"]
+	ks.ReplicationClass = class[strings.LastIndex(class, ".")+1:]
+
+	for option, value := range replication {
+		if option == "class" {
+			continue
+		}
+		factor, err := strconv.Atoi(value)
+		if err != nil {
+			return Keyspace{}, fmt.Errorf("failed to parse replication option %q of keyspace %s: %w", option, name, err)
+		}
+		switch {
+		case option == "replication_factor":
+			ks.ReplicationFactor = factor
+		case ks.ReplicationClass == NetworkTopologyStrategy:
+			if ks.DatacenterReplication == nil {
+				ks.DatacenterReplication = make(map[string]int)
+			}
+			ks.DatacenterReplication[option] = factor
+		}
+	}
+	return ks, nil
 }
 
 func (c *Cluster) DeleteKeyspace(ks Keyspace) error {
-	query := fmt.Sprintf(`DROP KEYSPACE IF EXISTS %s`, ks.Name)
-	return c.Session.Query(query).Exec()
+	query := fmt.Sprintf(`DROP KEYSPACE %s%s`, c.ifExists(true), QuoteIdentifier(ks.Name))
+	return c.execDDL(query)
+}
+
+// AlterKeyspace changes the replication and durable_writes of an existing keyspace in place,
+// keeping its data. The keyspace name cannot be changed, since CQL cannot rename keyspaces.
+func (c *Cluster) AlterKeyspace(ks Keyspace) error {
+	return c.AlterKeyspaceOptions(ks, KeyspaceChanges{Replication: true, DurableWrites: true})
+}
+
+// KeyspaceChanges selects the options of a keyspace that AlterKeyspaceOptions changes.
+type KeyspaceChanges struct {
+	Replication   bool
+	DurableWrites bool
+}
+
+// Any reports whether any option is selected.
+func (k KeyspaceChanges) Any() bool {
+	return k.Replication || k.DurableWrites
+}
+
+// KeyspaceChangesBetween returns the options of current that differ in desired.
+func KeyspaceChangesBetween(current, desired Keyspace) KeyspaceChanges {
+	return KeyspaceChanges{
+		Replication:   !sameReplication(current, desired),
+		DurableWrites: current.DurableWrites != desired.DurableWrites,
+	}
+}
+
+// AlterKeyspaceOptions changes only the options of ks selected by changes. Leaving the
+// replication out when it is unchanged avoids recomputing the token ownership of the keyspace.
+// Nothing is executed when no option is selected.
+func (c *Cluster) AlterKeyspaceOptions(ks Keyspace, changes KeyspaceChanges) error {
+	if !changes.Any() {
+		return nil
+	}
+	query := alterKeyspaceStatement(ks, changes)
+	log.Printf("Executing AlterKeyspace query: %s", query)
+	return c.execDDL(query)
+}
+
+// alterKeyspaceStatement returns the ALTER KEYSPACE statement changing the options of ks selected
+// by changes, at least one of which must be.
+func alterKeyspaceStatement(ks Keyspace, changes KeyspaceChanges) string {
+	var options []string
+	if changes.Replication {
+		options = append(options, "replication = "+ks.replication())
+	}
+	if changes.DurableWrites {
+		options = append(options, fmt.Sprintf("durable_writes = %v", ks.DurableWrites))
+	}
+	return fmt.Sprintf("ALTER KEYSPACE %s WITH %s", QuoteIdentifier(ks.Name), strings.Join(options, " AND "))
+}
+
+// AwaitSchemaAgreement waits until all nodes report the same schema version, so that objects
+// created by earlier statements can be relied on by the next ones.
+func (c *Cluster) AwaitSchemaAgreement() error {
+	ctx, cancel := c.ddlContext(c.operationContext())
+	defer cancel()
+	if err := c.WriteSession().AwaitSchemaAgreement(ctx); err != nil {
+		return errors.Join(errors.New("the nodes did not agree on the schema"), err)
+	}
+	return nil
+}
+
+// GetDatacenters returns the sorted names of the datacenters the cluster's nodes belong to,
+// as reported by system.local and system.peers.
+func (c *Cluster) GetDatacenters() ([]string, error) {
+	nodeCounts, err := c.GetDatacenterNodeCounts()
+	if err != nil {
+		return nil, err
+	}
+	return slices.Sorted(maps.Keys(nodeCounts)), nil
+}
+
+// GetDatacenterNodeCounts returns the number of nodes in each datacenter, as reported by
+// system.local and system.peers.
+func (c *Cluster) GetDatacenterNodeCounts() (map[string]int, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	nodeCounts := make(map[string]int)
+	for _, table := range []string{"system.local", "system.peers"} {
+		iter := c.ReadSession().Query(fmt.Sprintf(`SELECT data_center FROM %s`, table)).IterContext(ctx)
+		var dc string
+		for iter.Scan(&dc) {
+			nodeCounts[dc]++
+		}
+		if err := iter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to read datacenters from %s: %w", table, err)
+		}
+	}
+	return nodeCounts, nil
+}
+
+// AllDatacenters is the key EffectiveReplication reports SimpleStrategy keyspaces under, since
+// SimpleStrategy places replicas on the ring without regard to datacenters.
+const AllDatacenters = "all"
+
+// ReplicationShortfall is a datacenter with fewer nodes than the replicas requested in it.
+type ReplicationShortfall struct {
+	Datacenter string
+	Requested  int
+	Available  int
+}
+
+// EffectiveReplication returns the number of replicas the keyspace actually gets in each
+// datacenter, given the number of nodes in each one: a datacenter never holds more than one
+// replica per node. The datacenters where the requested replication factor is not met are
+// returned as shortfalls, sorted by name.
+func EffectiveReplication(ks Keyspace, nodeCounts map[string]int) (map[string]int, []ReplicationShortfall) {
+	requested := ks.DatacenterReplication
+	available := nodeCounts
+	if ks.ReplicationClass != NetworkTopologyStrategy {
+		requested = map[string]int{AllDatacenters: ks.ReplicationFactor}
+		total := 0
+		for _, count := range nodeCounts {
+			total += count
+		}
+		available = map[string]int{AllDatacenters: total}
+	}
+
+	effective := make(map[string]int, len(requested))
+	var shortfalls []ReplicationShortfall
+	for _, dc := range slices.Sorted(maps.Keys(requested)) {
+		effective[dc] = min(requested[dc], available[dc])
+		if effective[dc] < requested[dc] {
+			shortfalls = append(shortfalls, ReplicationShortfall{Datacenter: dc, Requested: requested[dc], Available: available[dc]})
+		}
+	}
+	return effective, shortfalls
+}
+
+// CheckReplicationDatacenters returns the datacenters in the keyspace's replication map that do
+// not exist in the cluster. A typo'd datacenter name would otherwise silently leave the data
+// unreplicated. When strict is true, unknown datacenters are also reported as an error wrapping
+// ErrUnknownDatacenter; otherwise the caller is expected to warn.
+func (c *Cluster) CheckReplicationDatacenters(ks Keyspace, strict bool) (unknown []string, err error) {
+	if ks.ReplicationClass != NetworkTopologyStrategy || len(ks.DatacenterReplication) == 0 {
+		return nil, nil
+	}
+	datacenters, err := c.GetDatacenters()
+	if err != nil {
+		return nil, err
+	}
+	for _, dc := range slices.Sorted(maps.Keys(ks.DatacenterReplication)) {
+		if !slices.Contains(datacenters, dc) {
+			unknown = append(unknown, dc)
+		}
+	}
+	if strict && len(unknown) > 0 {
+		return unknown, fmt.Errorf("%w: keyspace %s references %s; the cluster has %s",
+			ErrUnknownDatacenter, ks.Name, strings.Join(unknown, ", "), strings.Join(datacenters, ", "))
+	}
+	return unknown, nil
+}
+
+// replicationPollInterval is how often AwaitReplication re-reads the keyspace while waiting.
+const replicationPollInterval = 500 * time.Millisecond
+
+// AwaitReplication waits, within the DDL timeout, until the nodes agree on the schema and the
+// keyspace reports the replication settings of ks. CQL cannot trigger or observe a repair, so
+// this only guarantees that every node routes requests using the new replication map.
+func (c *Cluster) AwaitReplication(ks Keyspace) error {
+	ctx, cancel := c.ddlContext(c.operationContext())
+	defer cancel()
+	if err := c.WriteSession().AwaitSchemaAgreement(ctx); err != nil {
+		return errors.Join(errors.New("the nodes did not agree on the schema"), err)
+	}
+	var current map[string]string
+	for {
+		var durableWrites bool
+		query := "SELECT durable_writes, replication FROM system_schema.keyspaces WHERE keyspace_name = ?"
+		if err := c.WriteSession().Query(query, ks.Name).ScanContext(ctx, &durableWrites, &current); err != nil {
+			return fmt.Errorf("failed to read the replication of keyspace %s: %w", ks.Name, err)
+		}
+		got, err := keyspaceFromReplication(ks.Name, current, durableWrites)
+		if err != nil {
+			return err
+		}
+		if sameReplication(got, ks) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("keyspace %s did not converge to replication %s: %w", ks.Name, ks.replication(), ctx.Err())
+		case <-time.After(replicationPollInterval):
+		}
+	}
+}
+
+// sameReplication reports whether a and b use the same replication strategy and factors.
+func sameReplication(a, b Keyspace) bool {
+	if a.ReplicationClass != b.ReplicationClass {
+		return false
+	}
+	if a.ReplicationClass == NetworkTopologyStrategy {
+		return maps.Equal(a.DatacenterReplication, b.DatacenterReplication)
+	}
+	return a.ReplicationFactor == b.ReplicationFactor
 }
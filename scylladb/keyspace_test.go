@@ -0,0 +1,362 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyspaceReplication(t *testing.T) {
+	tests := []struct {
+		name string
+		ks   Keyspace
+		want string
+	}{
+		{
+			name: "simple strategy",
+			ks:   Keyspace{ReplicationClass: "SimpleStrategy", ReplicationFactor: 3},
+			want: `{'class': 'SimpleStrategy', 'replication_factor': 3}`,
+		},
+		{
+			name: "network topology strategy without datacenters",
+			ks:   Keyspace{ReplicationClass: NetworkTopologyStrategy, ReplicationFactor: 3},
+			want: `{'class': 'NetworkTopologyStrategy', 'replication_factor': 3}`,
+		},
+		{
+			name: "network topology strategy with datacenters",
+			ks: Keyspace{
+				ReplicationClass:      NetworkTopologyStrategy,
+				DatacenterReplication: map[string]int{"us-west": 2, "us-east": 3},
+			},
+			want: `{'class': 'NetworkTopologyStrategy', 'us-east': 3, 'us-west': 2}`,
+		},
+		{
+			name: "quotes are escaped",
+			ks: Keyspace{
+				ReplicationClass:      NetworkTopologyStrategy,
+				DatacenterReplication: map[string]int{"dc1': 1, 'dc2": 3},
+			},
+			want: `{'class': 'NetworkTopologyStrategy', 'dc1'': 1, ''dc2': 3}`,
+		},
+		{
+			name: "quoted class",
+			ks:   Keyspace{ReplicationClass: "Simple'Strategy", ReplicationFactor: 1},
+			want: `{'class': 'Simple''Strategy', 'replication_factor': 1}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.ks.replication())
+		})
+	}
+}
+
+func TestCheckReplicationDatacenters(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	datacenters, err := cluster.GetDatacenters()
+	require.NoError(t, err)
+	require.NotEmpty(t, datacenters)
+
+	ks := Keyspace{
+		Name:             "nts_check",
+		ReplicationClass: NetworkTopologyStrategy,
+		DatacenterReplication: map[string]int{
+			datacenters[0]: 1,
+			"no_such_dc":   1,
+		},
+	}
+
+	unknown, err := cluster.CheckReplicationDatacenters(ks, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"no_such_dc"}, unknown)
+
+	unknown, err = cluster.CheckReplicationDatacenters(ks, true)
+	assert.ErrorIs(t, err, ErrUnknownDatacenter)
+	assert.Equal(t, []string{"no_such_dc"}, unknown)
+
+	delete(ks.DatacenterReplication, "no_such_dc")
+	unknown, err = cluster.CheckReplicationDatacenters(ks, true)
+	require.NoError(t, err)
+	assert.Empty(t, unknown)
+}
+
+func TestCreateKeyspaceStrict(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	ks := Keyspace{
+		Name:              "strict_ks",
+		ReplicationClass:  "SimpleStrategy",
+		ReplicationFactor: 1,
+		DurableWrites:     true,
+	}
+	require.NoError(t, cluster.CreateKeyspaceStrict(ks))
+
+	// Creating it again reports the conflict instead of adopting the existing keyspace
+	ks.ReplicationFactor = 3
+	err := cluster.CreateKeyspaceStrict(ks)
+	assert.ErrorIs(t, err, ErrKeyspaceAlreadyExists)
+
+	// CreateKeyspace keeps ignoring it
+	assert.NoError(t, cluster.CreateKeyspace(ks))
+}
+
+func TestCreateKeyspaceDDLMode(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	ks := Keyspace{
+		Name:              "ddl_mode_ks",
+		ReplicationClass:  "SimpleStrategy",
+		ReplicationFactor: 1,
+		DurableWrites:     true,
+	}
+
+	// Strict: creating an existing keyspace and dropping a missing one fail
+	cluster.DDLMode = DDLModeStrict
+	require.NoError(t, cluster.CreateKeyspace(ks))
+	assert.Error(t, cluster.CreateKeyspace(ks))
+	assert.ErrorIs(t, cluster.CreateKeyspaceStrict(ks), ErrKeyspaceAlreadyExists)
+	require.NoError(t, cluster.DeleteKeyspace(ks))
+	assert.Error(t, cluster.DeleteKeyspace(ks))
+
+	// Idempotent: both succeed, even through CreateKeyspaceStrict
+	cluster.DDLMode = DDLModeIdempotent
+	require.NoError(t, cluster.CreateKeyspaceStrict(ks))
+	assert.NoError(t, cluster.CreateKeyspaceStrict(ks))
+	assert.NoError(t, cluster.CreateKeyspace(ks))
+	require.NoError(t, cluster.DeleteKeyspace(ks))
+	assert.NoError(t, cluster.DeleteKeyspace(ks))
+}
+
+func TestKeyspaceFromReplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		replication map[string]string
+		want        Keyspace
+		wantErr     bool
+	}{
+		{
+			name:        "SimpleStrategy",
+			replication: map[string]string{"class": "org.apache.cassandra.locator.SimpleStrategy", "replication_factor": "3"},
+			want:        Keyspace{Name: "ks", ReplicationClass: "SimpleStrategy", ReplicationFactor: 3, DurableWrites: true},
+		},
+		{
+			name:        "NetworkTopologyStrategy",
+			replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "dc1": "3", "dc2": "1"},
+			want: Keyspace{
+				Name:                  "ks",
+				ReplicationClass:      NetworkTopologyStrategy,
+				DatacenterReplication: map[string]int{"dc1": 3, "dc2": 1},
+				DurableWrites:         true,
+			},
+		},
+		{
+			name:        "unqualified class",
+			replication: map[string]string{"class": "SimpleStrategy", "replication_factor": "1"},
+			want:        Keyspace{Name: "ks", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true},
+		},
+		{
+			name:        "invalid factor",
+			replication: map[string]string{"class": "org.apache.cassandra.locator.NetworkTopologyStrategy", "dc1": "three"},
+			wantErr:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := keyspaceFromReplication("ks", tc.replication, true)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetKeyspace(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	datacenters, err := cluster.GetDatacenters()
+	require.NoError(t, err)
+	require.NotEmpty(t, datacenters)
+
+	for _, ks := range []Keyspace{
+		{Name: "simple_ks", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true},
+		{Name: "nts_ks", ReplicationClass: NetworkTopologyStrategy, DatacenterReplication: map[string]int{datacenters[0]: 1}, DurableWrites: false},
+	} {
+		t.Run(ks.ReplicationClass, func(t *testing.T) {
+			require.NoError(t, cluster.CreateKeyspace(ks))
+			got, err := cluster.GetKeyspace(ks.Name)
+			require.NoError(t, err)
+			assert.Equal(t, ks, got)
+		})
+	}
+
+	_, err = cluster.GetKeyspace("no_such_keyspace")
+	assert.ErrorIs(t, err, ErrKeyspaceNotFound)
+}
+
+func TestMixedCaseKeyspace(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	ks := Keyspace{Name: "MixedCase", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true}
+	require.NoError(t, cluster.CreateKeyspace(ks))
+	got, err := cluster.GetKeyspace("MixedCase")
+	require.NoError(t, err)
+	assert.Equal(t, ks, got)
+
+	// The name is not lowercased
+	_, err = cluster.GetKeyspace("mixedcase")
+	assert.ErrorIs(t, err, ErrKeyspaceNotFound)
+	assert.ErrorIs(t, cluster.CreateKeyspaceStrict(ks), ErrKeyspaceAlreadyExists)
+
+	ks.DurableWrites = false
+	require.NoError(t, cluster.AlterKeyspace(ks))
+	got, err = cluster.GetKeyspace("MixedCase")
+	require.NoError(t, err)
+	assert.False(t, got.DurableWrites)
+
+	require.NoError(t, cluster.DeleteKeyspace(ks))
+	_, err = cluster.GetKeyspace("MixedCase")
+	assert.ErrorIs(t, err, ErrKeyspaceNotFound)
+}
+
+func TestListKeyspaces(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	created := []Keyspace{
+		{Name: "list_b", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true},
+		{Name: "list_a", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: false},
+	}
+	for _, ks := range created {
+		require.NoError(t, cluster.CreateKeyspace(ks))
+	}
+
+	keyspaces, err := cluster.ListKeyspaces(false)
+	require.NoError(t, err)
+	var names []string
+	for _, ks := range keyspaces {
+		assert.False(t, IsSystemKeyspace(ks.Name), ks.Name)
+		names = append(names, ks.Name)
+	}
+	assert.True(t, slices.IsSorted(names))
+	assert.Contains(t, keyspaces, created[0])
+	assert.Contains(t, keyspaces, created[1])
+
+	keyspaces, err = cluster.ListKeyspaces(true)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(keyspaces, func(ks Keyspace) bool { return ks.Name == "system_schema" }))
+}
+
+func TestAwaitReplication(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	datacenters, err := cluster.GetDatacenters()
+	require.NoError(t, err)
+	require.NotEmpty(t, datacenters)
+
+	ks := Keyspace{Name: "converge_ks", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true}
+	require.NoError(t, cluster.CreateKeyspace(ks))
+	require.NoError(t, cluster.AwaitReplication(ks))
+
+	ks.ReplicationClass = NetworkTopologyStrategy
+	ks.ReplicationFactor = 0
+	ks.DatacenterReplication = map[string]int{datacenters[0]: 1}
+	require.NoError(t, cluster.AlterKeyspace(ks))
+	require.NoError(t, cluster.AwaitReplication(ks))
+
+	// A replication map the keyspace never reaches times out instead of waiting forever
+	cluster.DDLTimeout = time.Second
+	ks.DatacenterReplication = map[string]int{datacenters[0]: 2}
+	assert.ErrorIs(t, cluster.AwaitReplication(ks), context.DeadlineExceeded)
+}
+
+func TestSameReplication(t *testing.T) {
+	simple := Keyspace{ReplicationClass: "SimpleStrategy", ReplicationFactor: 3}
+	nts := Keyspace{ReplicationClass: NetworkTopologyStrategy, DatacenterReplication: map[string]int{"dc1": 3}}
+	assert.True(t, sameReplication(simple, simple))
+	assert.True(t, sameReplication(nts, nts))
+	assert.False(t, sameReplication(simple, nts))
+	assert.False(t, sameReplication(simple, Keyspace{ReplicationClass: "SimpleStrategy", ReplicationFactor: 1}))
+	assert.False(t, sameReplication(nts, Keyspace{ReplicationClass: NetworkTopologyStrategy, DatacenterReplication: map[string]int{"dc1": 3, "dc2": 1}}))
+}
+
+func TestAlterKeyspaceStatement(t *testing.T) {
+	current := Keyspace{Name: "ks", ReplicationClass: NetworkTopologyStrategy, DatacenterReplication: map[string]int{"dc1": 3}, DurableWrites: true}
+
+	desired := current
+	desired.DurableWrites = false
+	changes := KeyspaceChangesBetween(current, desired)
+	assert.Equal(t, KeyspaceChanges{DurableWrites: true}, changes)
+	assert.Equal(t, `ALTER KEYSPACE "ks" WITH durable_writes = false`, alterKeyspaceStatement(desired, changes))
+
+	desired = current
+	desired.DatacenterReplication = map[string]int{"dc1": 3, "dc2": 1}
+	changes = KeyspaceChangesBetween(current, desired)
+	assert.Equal(t, KeyspaceChanges{Replication: true}, changes)
+	assert.Equal(t, `ALTER KEYSPACE "ks" WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': 3, 'dc2': 1}`, alterKeyspaceStatement(desired, changes))
+
+	desired.DurableWrites = false
+	assert.Equal(t, `ALTER KEYSPACE "ks" WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': 3, 'dc2': 1} AND durable_writes = false`,
+		alterKeyspaceStatement(desired, KeyspaceChangesBetween(current, desired)))
+
+	assert.False(t, KeyspaceChangesBetween(current, current).Any())
+}
+
+func TestEffectiveReplication(t *testing.T) {
+	nodeCounts := map[string]int{"dc1": 3, "dc2": 1}
+
+	effective, shortfalls := EffectiveReplication(Keyspace{
+		ReplicationClass:      NetworkTopologyStrategy,
+		DatacenterReplication: map[string]int{"dc1": 3, "dc2": 3, "dc3": 1},
+	}, nodeCounts)
+	assert.Equal(t, map[string]int{"dc1": 3, "dc2": 1, "dc3": 0}, effective)
+	assert.Equal(t, []ReplicationShortfall{
+		{Datacenter: "dc2", Requested: 3, Available: 1},
+		{Datacenter: "dc3", Requested: 1, Available: 0},
+	}, shortfalls)
+
+	// SimpleStrategy places replicas across all datacenters
+	effective, shortfalls = EffectiveReplication(Keyspace{ReplicationClass: "SimpleStrategy", ReplicationFactor: 3}, nodeCounts)
+	assert.Equal(t, map[string]int{AllDatacenters: 3}, effective)
+	assert.Empty(t, shortfalls)
+
+	effective, shortfalls = EffectiveReplication(Keyspace{ReplicationClass: "SimpleStrategy", ReplicationFactor: 3}, map[string]int{"dc1": 1})
+	assert.Equal(t, map[string]int{AllDatacenters: 1}, effective)
+	assert.Equal(t, []ReplicationShortfall{{Datacenter: AllDatacenters, Requested: 3, Available: 1}}, shortfalls)
+}
+
+func TestGetDatacenterNodeCounts(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	nodeCounts, err := cluster.GetDatacenterNodeCounts()
+	require.NoError(t, err)
+	require.Len(t, nodeCounts, 1)
+	for _, count := range nodeCounts {
+		assert.Equal(t, 1, count)
+	}
+}
+
+func TestIsSystemKeyspace(t *testing.T) {
+	for _, name := range []string{"system", "system_schema", "System_Auth"} {
+		assert.True(t, IsSystemKeyspace(name), name)
+	}
+	for _, name := range []string{"", "cycling", "systems", "my_system"} {
+		assert.False(t, IsSystemKeyspace(name), name)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// Metrics is a snapshot of the queries the cluster ran since it was created.
+type Metrics struct {
+	// Queries counts query attempts, so a query that was retried counts more than once.
+	Queries int64
+	// Errors counts the query attempts that failed.
+	Errors int64
+	// AverageLatency is the mean duration of a query attempt, or zero before the first one.
+	AverageLatency time.Duration
+}
+
+// queryMetrics counts the queries of the session. It observes every query attempt and passes
+// it on to the query observer configured before the session was created, if any.
+type queryMetrics struct {
+	next gocql.QueryObserver
+
+	queries      atomic.Int64
+	errors       atomic.Int64
+	totalLatency atomic.Int64
+}
+
+var _ gocql.QueryObserver = &queryMetrics{}
+
+func (m *queryMetrics) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	m.queries.Add(1)
+	if q.Err != nil {
+		m.errors.Add(1)
+	}
+	m.totalLatency.Add(int64(q.End.Sub(q.Start)))
+	if m.next != nil {
+		m.next.ObserveQuery(ctx, q)
+	}
+}
+
+// observe installs m as the query observer of config, chained in front of the observer that
+// was configured on it.
+func (m *queryMetrics) observe(config *gocql.ClusterConfig) {
+	if config.QueryObserver == gocql.QueryObserver(m) {
+		return
+	}
+	m.next = config.QueryObserver
+	config.QueryObserver = m
+}
+
+func (m *queryMetrics) snapshot() Metrics {
+	metrics := Metrics{
+		Queries: m.queries.Load(),
+		Errors:  m.errors.Load(),
+	}
+	if metrics.Queries > 0 {
+		metrics.AverageLatency = time.Duration(m.totalLatency.Load() / metrics.Queries)
+	}
+	return metrics
+}
+
+// Metrics returns the number of queries the cluster ran, how many of them failed, and their
+// average latency. Queries run before CreateSession, or by other sessions, are not counted.
+func (c *Cluster) Metrics() Metrics {
+	return c.metrics.snapshot()
+}
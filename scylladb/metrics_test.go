@@ -0,0 +1,60 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingObserver counts the queries it observes.
+type countingObserver struct {
+	queries int
+}
+
+func (o *countingObserver) ObserveQuery(_ context.Context, _ gocql.ObservedQuery) {
+	o.queries++
+}
+
+func TestQueryMetrics(t *testing.T) {
+	metrics := &queryMetrics{}
+	assert.Equal(t, Metrics{}, metrics.snapshot())
+
+	config := gocql.NewCluster("127.0.0.1")
+	next := &countingObserver{}
+	config.QueryObserver = next
+	metrics.observe(config)
+	// Installing the metrics twice does not chain them to themselves
+	metrics.observe(config)
+	assert.Same(t, metrics, config.QueryObserver)
+
+	start := time.Now()
+	config.QueryObserver.ObserveQuery(context.Background(), gocql.ObservedQuery{Start: start, End: start.Add(10 * time.Millisecond)})
+	config.QueryObserver.ObserveQuery(context.Background(), gocql.ObservedQuery{Start: start, End: start.Add(30 * time.Millisecond), Err: errors.New("timeout")})
+
+	assert.Equal(t, Metrics{Queries: 2, Errors: 1, AverageLatency: 20 * time.Millisecond}, metrics.snapshot())
+	assert.Equal(t, 2, next.queries)
+}
+
+func TestClusterMetrics(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	before := cluster.Metrics()
+	_, err := cluster.GetRole("cassandra")
+	require.NoError(t, err)
+	_, err = cluster.GetRole("no_such_role")
+	require.ErrorIs(t, err, ErrRoleNotFound)
+	assert.Error(t, cluster.exec("SELECT * FROM no_such_keyspace.no_such_table"))
+
+	after := cluster.Metrics()
+	assert.GreaterOrEqual(t, after.Queries-before.Queries, int64(3))
+	assert.GreaterOrEqual(t, after.Errors-before.Errors, int64(1))
+	assert.Positive(t, after.AverageLatency)
+}
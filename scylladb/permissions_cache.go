@@ -0,0 +1,71 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// DefaultPermissionsValidity is ScyllaDB's default permissions_validity_in_ms.
+const DefaultPermissionsValidity = 10 * time.Second
+
+// PermissionsValidity returns how long a node caches the permissions of a role, read from the
+// permissions_validity_in_ms setting in system.config. The default is returned when the setting
+// is not exposed.
+func (c *Cluster) PermissionsValidity() (time.Duration, error) {
+	value, found, err := c.configValue("permissions_validity_in_ms")
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return DefaultPermissionsValidity, nil
+	}
+	ms, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse permissions_validity_in_ms %q: %w", value, err)
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// configValue reads a setting from system.config. Values are JSON encoded there, so the quotes
+// around strings are removed.
+func (c *Cluster) configValue(name string) (value string, found bool, err error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	err = c.ReadSession().Query("SELECT value FROM system.config WHERE name = ?", name).ScanContext(ctx, &value)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return strings.Trim(value, `"`), true, nil
+}
+
+// AwaitPermissionsCacheRefresh waits out the permissions cache so grant changes made to roleName
+// take effect for existing sessions, then re-reads the grants of the role. ScyllaDB has no CQL
+// statement to invalidate the cache, so wait is called with the cache validity and is expected to
+// block for that long.
+func (c *Cluster) AwaitPermissionsCacheRefresh(roleName string, wait func(time.Duration) error) (validity time.Duration, grants []Grant, err error) {
+	if _, err := c.GetRole(roleName); err != nil {
+		return 0, nil, err
+	}
+	validity, err = c.PermissionsValidity()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := wait(validity); err != nil {
+		return 0, nil, err
+	}
+	grants, err = c.ListAllGrants(roleName)
+	if err != nil {
+		return 0, nil, err
+	}
+	return validity, grants, nil
+}
@@ -0,0 +1,37 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAwaitPermissionsCacheRefresh(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	setupTestKSAndTable(t, cluster)
+	require.NoError(t, cluster.CreateRole(Role{Role: "cached_reader"}))
+
+	grant := Grant{RoleName: "cached_reader", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"}
+	var waited time.Duration
+	validity, grants, err := cluster.AwaitPermissionsCacheRefresh("cached_reader", func(d time.Duration) error {
+		waited = d
+		// A grant made while waiting must be seen by the re-verification afterwards
+		return cluster.CreateGrant(grant)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPermissionsValidity, validity)
+	assert.Equal(t, validity, waited)
+	assert.Equal(t, []Grant{grant}, grants)
+
+	_, _, err = cluster.AwaitPermissionsCacheRefresh("no_such_role", func(time.Duration) error {
+		t.Fatal("wait must not be called for a missing role")
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
@@ -0,0 +1,132 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// authKeyspaceCandidates are the keyspaces ScyllaDB keeps roles in. Releases with auth v2 use
+// system, older ones system_auth.
+var authKeyspaceCandidates = []string{"system", "system_auth"}
+
+// PreflightReport summarizes the health of the cluster as seen by the provider.
+type PreflightReport struct {
+	// ReleaseVersion is the CQL release version reported by the coordinator.
+	ReleaseVersion string
+	// Latency is the round trip time of the ping query.
+	Latency     time.Duration
+	Datacenters []string
+	// AuthKeyspace is the configured system auth keyspace and AuthKeyspaceReady whether it holds
+	// the roles table. When it does not, DetectedAuthKeyspace names the keyspace that does, if any.
+	AuthKeyspace         string
+	AuthKeyspaceReady    bool
+	DetectedAuthKeyspace string
+	Authenticator        string
+	Authorizer           string
+}
+
+// AuthorizationEnabled reports whether the cluster enforces grants. With AllowAllAuthorizer every
+// role may do anything and grants have no effect.
+func (r PreflightReport) AuthorizationEnabled() bool {
+	return r.Authorizer != "" && !strings.HasSuffix(r.Authorizer, "AllowAllAuthorizer")
+}
+
+// Problems returns a description of each check that failed, or nothing when the cluster is ready
+// to be managed.
+func (r PreflightReport) Problems() []string {
+	var problems []string
+	if !r.AuthKeyspaceReady {
+		problem := fmt.Sprintf("the system auth keyspace %q has no roles table", r.AuthKeyspace)
+		if r.DetectedAuthKeyspace != "" {
+			problem += fmt.Sprintf("; roles are stored in %q, set system_auth_keyspace to it", r.DetectedAuthKeyspace)
+		}
+		problems = append(problems, problem)
+	}
+	if !r.AuthorizationEnabled() {
+		problems = append(problems, fmt.Sprintf("authorization is disabled (authorizer %q), so grants have no effect", r.Authorizer))
+	}
+	return problems
+}
+
+// Ping runs a trivial query and returns the release version reported by the coordinator along
+// with the round trip time.
+func (c *Cluster) Ping() (releaseVersion string, latency time.Duration, err error) {
+	c.pingIfIdle()
+	return c.ping()
+}
+
+// ping is Ping without the idle check, so that it can be used by the idle check itself.
+func (c *Cluster) ping() (releaseVersion string, latency time.Duration, err error) {
+	ctx, cancel := timeoutContext(c.operationContext(), c.RequestTimeout)
+	defer cancel()
+	start := time.Now()
+	if err := c.WriteSession().Query("SELECT release_version FROM system.local").ScanContext(ctx, &releaseVersion); err != nil {
+		return "", 0, err
+	}
+	return releaseVersion, time.Since(start), nil
+}
+
+// Preflight checks that the cluster is reachable, that the configured system auth keyspace holds
+// the roles, and whether authorization is enabled. It only reads from the cluster, so it is safe
+// to run at any time. An error is returned when a check could not be run at all; checks that ran
+// and failed are reported by PreflightReport.Problems.
+func (c *Cluster) Preflight() (PreflightReport, error) {
+	report := PreflightReport{AuthKeyspace: c.SystemAuthKeyspaceName}
+	var err error
+	report.ReleaseVersion, report.Latency, err = c.Ping()
+	if err != nil {
+		return PreflightReport{}, fmt.Errorf("failed to ping the cluster: %w", err)
+	}
+	report.Datacenters, err = c.GetDatacenters()
+	if err != nil {
+		return PreflightReport{}, err
+	}
+
+	report.AuthKeyspaceReady, err = c.hasRolesTable(c.SystemAuthKeyspaceName)
+	if err != nil {
+		return PreflightReport{}, err
+	}
+	if !report.AuthKeyspaceReady {
+		for _, keyspace := range authKeyspaceCandidates {
+			if keyspace == c.SystemAuthKeyspaceName {
+				continue
+			}
+			found, err := c.hasRolesTable(keyspace)
+			if err != nil {
+				return PreflightReport{}, err
+			}
+			if found {
+				report.DetectedAuthKeyspace = keyspace
+				break
+			}
+		}
+	}
+
+	if report.Authenticator, _, err = c.configValue("authenticator"); err != nil {
+		return PreflightReport{}, fmt.Errorf("failed to read the authenticator setting: %w", err)
+	}
+	if report.Authorizer, _, err = c.configValue("authorizer"); err != nil {
+		return PreflightReport{}, fmt.Errorf("failed to read the authorizer setting: %w", err)
+	}
+	return report, nil
+}
+
+func (c *Cluster) hasRolesTable(keyspace string) (bool, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	var tableName string
+	err := c.WriteSession().Query("SELECT table_name FROM system_schema.tables WHERE keyspace_name = ? AND table_name = 'roles'", keyspace).ScanContext(ctx, &tableName)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to look up the roles table in %s: %w", keyspace, err)
+	}
+	return true, nil
+}
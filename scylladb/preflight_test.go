@@ -0,0 +1,49 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreflightReportProblems(t *testing.T) {
+	healthy := PreflightReport{AuthKeyspace: "system", AuthKeyspaceReady: true, Authorizer: "CassandraAuthorizer"}
+	assert.True(t, healthy.AuthorizationEnabled())
+	assert.Empty(t, healthy.Problems())
+
+	unhealthy := PreflightReport{
+		AuthKeyspace:         "system_auth",
+		DetectedAuthKeyspace: "system",
+		Authorizer:           "org.apache.cassandra.auth.AllowAllAuthorizer",
+	}
+	assert.False(t, unhealthy.AuthorizationEnabled())
+	assert.Equal(t, []string{
+		`the system auth keyspace "system_auth" has no roles table; roles are stored in "system", set system_auth_keyspace to it`,
+		`authorization is disabled (authorizer "org.apache.cassandra.auth.AllowAllAuthorizer"), so grants have no effect`,
+	}, unhealthy.Problems())
+}
+
+func TestPreflight(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	report, err := cluster.Preflight()
+	require.NoError(t, err)
+	assert.NotEmpty(t, report.ReleaseVersion)
+	assert.Positive(t, report.Latency)
+	assert.NotEmpty(t, report.Datacenters)
+	assert.Equal(t, "system", report.AuthKeyspace)
+	assert.True(t, report.AuthKeyspaceReady)
+	assert.True(t, report.AuthorizationEnabled())
+	assert.Empty(t, report.Problems())
+
+	// Pointing at a keyspace without roles is reported, along with where the roles are
+	cluster.SetSystemAuthKeyspace("system_schema")
+	report, err = cluster.Preflight()
+	require.NoError(t, err)
+	assert.False(t, report.AuthKeyspaceReady)
+	assert.Equal(t, "system", report.DetectedAuthKeyspace)
+}
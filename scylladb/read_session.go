@@ -0,0 +1,75 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"strings"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+// readSessionConfig holds the consistency of the dedicated read session.
+type readSessionConfig struct {
+	consistency gocql.Consistency
+}
+
+// SetReadSession makes CreateSession open a second session dedicated to reads, which queries at
+// consistency and routes each query to a replica of the data it reads. The primary session keeps
+// the configured consistency and routing for writes. Reads at a weaker consistency may not see a
+// write that has not reached every replica yet. An empty consistency reads with the primary
+// session, which is the default.
+func (c *Cluster) SetReadSession(consistency string) error {
+	if consistency == "" {
+		c.readConfig = nil
+		return nil
+	}
+	parsed, err := gocql.ParseConsistencyWrapper(strings.ToUpper(consistency))
+	if err != nil {
+		return err
+	}
+	c.readConfig = &readSessionConfig{consistency: parsed}
+	return nil
+}
+
+// WriteSession returns the session for schema, role, and permission changes.
+func (c *Cluster) WriteSession() *gocql.Session {
+	return c.Session
+}
+
+// ReadSession returns the session for reads: the dedicated read session when SetReadSession
+// configured one, or the primary session.
+func (c *Cluster) ReadSession() *gocql.Session {
+	if c.readSession != nil {
+		return c.readSession
+	}
+	return c.Session
+}
+
+// createReadSession opens the dedicated read session configured by SetReadSession, if any.
+func (c *Cluster) createReadSession() error {
+	if c.readConfig == nil {
+		return nil
+	}
+	config := *c.connectConfig()
+	config.Consistency = c.readConfig.consistency
+	config.PoolConfig.HostSelectionPolicy = c.newHostSelectionPolicy()
+	if config.PoolConfig.HostSelectionPolicy == nil {
+		config.PoolConfig.HostSelectionPolicy = gocql.TokenAwareHostPolicy(gocql.RoundRobinHostPolicy())
+	}
+	session, err := config.CreateSession()
+	if err != nil {
+		return classifySessionError(err)
+	}
+	c.readSession = session
+	return nil
+}
+
+// Close closes the sessions of the cluster.
+func (c *Cluster) Close() {
+	if c.readSession != nil {
+		c.readSession.Close()
+	}
+	if c.Session != nil {
+		c.Session.Close()
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReadSession(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.Error(t, cluster.SetReadSession("SOMETIMES"))
+	require.NoError(t, cluster.SetReadSession("local_one"))
+	assert.Equal(t, gocql.LocalOne, cluster.readConfig.consistency)
+	require.NoError(t, cluster.SetReadSession(""))
+	assert.Nil(t, cluster.readConfig)
+}
+
+// TestReadSession verifies that writes through the primary session and reads through the
+// dedicated read session both work, and that the read session uses its own consistency.
+func TestReadSession(t *testing.T) {
+	host := testutil.NewTestContainer(t)
+	cluster, err := NewClusterConfig([]string{host})
+	require.NoError(t, err)
+	cluster.SetSystemAuthKeyspace("system")
+	cluster.SetUserPasswordAuth("cassandra", "cassandra")
+	require.NoError(t, cluster.SetReadSession("ONE"))
+	require.NoError(t, cluster.CreateSession())
+	defer cluster.Close()
+
+	assert.NotSame(t, cluster.WriteSession(), cluster.ReadSession())
+	assert.Equal(t, gocql.One, cluster.ReadSession().Query("SELECT release_version FROM system.local").GetConsistency())
+	assert.Equal(t, cluster.Cluster.Consistency, cluster.WriteSession().Query("SELECT release_version FROM system.local").GetConsistency())
+
+	require.NoError(t, cluster.CreateRole(Role{Role: "reader", CanLogin: true, Password: "secret"}))
+	role, err := cluster.GetRole("reader")
+	require.NoError(t, err)
+	assert.True(t, role.CanLogin)
+	require.NoError(t, cluster.DeleteRole(Role{Role: "reader"}))
+}
@@ -0,0 +1,78 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+)
+
+// SetResolver sets the resolver used to look up the host names among the contact points. Each
+// cluster holds its own resolver, so configuring one never affects net.DefaultResolver or other
+// clusters in the same process.
+func (c *Cluster) SetResolver(resolver *net.Resolver) {
+	c.resolver = resolver
+}
+
+// resolveContactPoints replaces the host names among the contact points with the addresses the
+// cluster's resolver returns for them. gocql runs with DisableInitialHostLookup, so it would
+// otherwise resolve them with the global resolver. Hosts behind a proxy are resolved by the proxy
+// and a Unix socket has nothing to resolve, so those contact points are left unchanged.
+func (c *Cluster) resolveContactPoints(ctx context.Context) error {
+	switch c.Cluster.HostDialer.(type) {
+	case *ProxyHostDialer, *UnixSocketDialer:
+		return nil
+	}
+	resolver := c.resolver
+	if resolver == nil {
+		resolver = &net.Resolver{}
+	}
+
+	// Host names are looked up concurrently, bounded by ConnectConcurrency, and the addresses
+	// are kept in the order of the contact points.
+	addrs := make([][]string, len(c.Cluster.Hosts))
+	errs := make([]error, len(c.Cluster.Hosts))
+	limit := c.ConnectConcurrency
+	if limit <= 0 {
+		limit = len(c.Cluster.Hosts)
+	}
+	slots := make(chan struct{}, max(limit, 1))
+	var wg sync.WaitGroup
+	for i, host := range c.Cluster.Hosts {
+		hostPart, portPart, err := net.SplitHostPort(host)
+		if err != nil {
+			hostPart, portPart = host, ""
+		}
+		if net.ParseIP(hostPart) != nil {
+			addrs[i] = []string{host}
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			hostAddrs, err := resolver.LookupHost(ctx, hostPart)
+			if err != nil {
+				errs[i] = fmt.Errorf("%w: failed to resolve contact point %s: %v", ErrUnreachable, hostPart, err)
+				return
+			}
+			for _, addr := range hostAddrs {
+				if portPart != "" {
+					addr = net.JoinHostPort(addr, portPart)
+				}
+				addrs[i] = append(addrs[i], addr)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	c.Cluster.Hosts = slices.Concat(addrs...)
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// newStaticResolver returns a resolver that answers A queries from records over an in-memory
+// connection instead of asking a real DNS server.
+func newStaticResolver(t *testing.T, records map[string][4]byte) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(context.Context, string, string) (net.Conn, error) {
+			client, server := net.Pipe()
+			go serveDNS(t, server, records)
+			return client, nil
+		},
+	}
+}
+
+// serveDNS answers a single length-prefixed DNS query, as the Go resolver sends over a stream
+// connection.
+func serveDNS(t *testing.T, conn net.Conn, records map[string][4]byte) {
+	defer conn.Close()
+	var length uint16
+	if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+		return
+	}
+	query := make([]byte, length)
+	if _, err := io.ReadFull(conn, query); err != nil {
+		return
+	}
+	var request dnsmessage.Message
+	if err := request.Unpack(query); err != nil {
+		t.Errorf("failed to parse DNS query: %s", err)
+		return
+	}
+
+	question := request.Questions[0]
+	response := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: request.ID, Response: true, Authoritative: true, RCode: dnsmessage.RCodeSuccess},
+		Questions: request.Questions,
+	}
+	ip, ok := records[question.Name.String()]
+	switch {
+	case !ok:
+		response.RCode = dnsmessage.RCodeNameError
+	case question.Type == dnsmessage.TypeA:
+		response.Answers = []dnsmessage.Resource{{
+			Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60},
+			Body:   &dnsmessage.AResource{A: ip},
+		}}
+	}
+	packed, err := response.Pack()
+	if err != nil {
+		t.Errorf("failed to pack DNS response: %s", err)
+		return
+	}
+	_ = binary.Write(conn, binary.BigEndian, uint16(len(packed)))
+	_, _ = conn.Write(packed)
+}
+
+func TestResolveContactPoints(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"scylla.internal.test:19042", "10.0.0.9", "scylla.internal.test"})
+	require.NoError(t, err)
+	cluster.SetResolver(newStaticResolver(t, map[string][4]byte{"scylla.internal.test.": {10, 1, 2, 3}}))
+
+	require.NoError(t, cluster.resolveContactPoints(context.Background()))
+	assert.Equal(t, []string{"10.1.2.3:19042", "10.0.0.9", "10.1.2.3"}, cluster.Cluster.Hosts)
+}
+
+func TestResolveContactPointsUsesClusterResolver(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"scylla.internal.test"})
+	require.NoError(t, err)
+	cluster.SetResolver(&net.Resolver{
+		PreferGo: true,
+		Dial: func(context.Context, string, string) (net.Conn, error) {
+			return nil, errors.New("resolver unavailable")
+		},
+	})
+
+	err = cluster.resolveContactPoints(context.Background())
+	assert.ErrorIs(t, err, ErrUnreachable)
+	assert.ErrorContains(t, err, "failed to resolve contact point scylla.internal.test")
+	assert.Equal(t, []string{"scylla.internal.test"}, cluster.Cluster.Hosts)
+}
+
+func TestResolveContactPointsThroughProxy(t *testing.T) {
+	cluster, err := NewClusterConfigWithProxy([]string{"scylla.internal.test"}, "socks5://127.0.0.1:1080")
+	require.NoError(t, err)
+	cluster.SetResolver(&net.Resolver{
+		PreferGo: true,
+		Dial: func(context.Context, string, string) (net.Conn, error) {
+			return nil, errors.New("resolver unavailable")
+		},
+	})
+
+	// The proxy resolves the host name, so the dummy contact points are left alone
+	hosts := cluster.Cluster.Hosts
+	require.NoError(t, cluster.resolveContactPoints(context.Background()))
+	assert.Equal(t, hosts, cluster.Cluster.Hosts)
+}
@@ -0,0 +1,33 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+const (
+	// retryBackoffMin is the delay before the first retry with backoff; it doubles for each
+	// further retry, up to retryBackoffMax.
+	retryBackoffMin = 100 * time.Millisecond
+	retryBackoffMax = 5 * time.Second
+)
+
+// SetRetryPolicy makes the driver retry a failed idempotent statement on the next host up to
+// attempts times, e.g. while a node restarts. With backoff, it waits before each retry, from
+// 100ms doubling up to 5s; otherwise it retries right away. Only statements that have the same
+// effect when run again, such as GRANT and REVOKE, are retried: a statement that fails when the
+// object already exists or is already gone, such as DROP ROLE without IF EXISTS, still fails.
+// Zero attempts disables retries, the default.
+func (c *Cluster) SetRetryPolicy(attempts int, backoff bool) {
+	switch {
+	case attempts <= 0:
+		c.Cluster.RetryPolicy = nil
+	case backoff:
+		c.Cluster.RetryPolicy = &gocql.ExponentialBackoffRetryPolicy{NumRetries: attempts, Min: retryBackoffMin, Max: retryBackoffMax}
+	default:
+		c.Cluster.RetryPolicy = &gocql.SimpleRetryPolicy{NumRetries: attempts}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"testing"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetRetryPolicy(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+
+	cluster.SetRetryPolicy(3, false)
+	assert.Equal(t, &gocql.SimpleRetryPolicy{NumRetries: 3}, cluster.Cluster.RetryPolicy)
+	assert.Equal(t, 3, cluster.EffectiveConfig()["retry_attempts"])
+	assert.NotContains(t, cluster.EffectiveConfig(), "retry_backoff")
+
+	cluster.SetRetryPolicy(2, true)
+	assert.Equal(t, &gocql.ExponentialBackoffRetryPolicy{NumRetries: 2, Min: retryBackoffMin, Max: retryBackoffMax}, cluster.Cluster.RetryPolicy)
+	assert.Equal(t, true, cluster.EffectiveConfig()["retry_backoff"])
+
+	cluster.SetRetryPolicy(0, true)
+	assert.Nil(t, cluster.Cluster.RetryPolicy)
+	assert.NotContains(t, cluster.EffectiveConfig(), "retry_attempts")
+}
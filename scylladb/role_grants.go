@@ -0,0 +1,177 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+)
+
+// ListAllGrants returns every grant held directly by roleName, one Grant per privilege, read from
+// the role_permissions system table. Inherited grants are not included. Grants on resources
+// other than data (keyspaces and tables) and roles are skipped.
+func (c *Cluster) ListAllGrants(roleName string) ([]Grant, error) {
+	queryStr := fmt.Sprintf("SELECT resource, permissions FROM %s.role_permissions WHERE role = ?", c.SystemAuthKeyspaceName)
+	log.Printf("Executing ReadGrant query: %s", queryStr)
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query(queryStr, roleName).IterContext(ctx)
+
+	var grants []Grant
+	var resource string
+	var permissions []string
+	for iter.Scan(&resource, &permissions) {
+		grant, ok := parseResourceName(resource)
+		if !ok {
+			log.Printf("Skipping grants of role %s on unsupported resource %s", roleName, resource)
+			permissions = nil
+			continue
+		}
+		grant.RoleName = roleName
+		for _, permission := range normalizePermissions(permissions) {
+			grant.Privilege = permission
+			grants = append(grants, grant)
+		}
+		permissions = nil
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(grants, CompareGrants)
+	return grants, nil
+}
+
+// ReconcileGrants makes the data grants of roleName exactly match desired in one pass: grants
+// on keyspaces and tables that are not desired are revoked and missing ones are granted. Grants
+// on other resources are left untouched. It returns the grants that were added and removed, so
+// re-running it with the same desired grants, in any order, changes nothing. When a statement
+// fails, the grants added and removed before it are returned with the error.
+func (c *Cluster) ReconcileGrants(roleName string, desired []Grant) (added, removed []Grant, err error) {
+	for _, grant := range desired {
+		if !slices.Contains(ValidPrivileges, strings.ToUpper(grant.Privilege)) {
+			return nil, nil, fmt.Errorf("privilege %q is not allowed in an authoritative grant", grant.Privilege)
+		}
+	}
+
+	current, err := c.ListAllGrants(roleName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toAdd, toRemove := diffGrants(roleName, current, desired)
+	for _, grant := range toRemove {
+		if err := c.DeleteGrant(grant); err != nil {
+			return added, removed, err
+		}
+		removed = append(removed, grant)
+	}
+	for _, grant := range toAdd {
+		if err := c.CreateGrant(grant); err != nil {
+			return added, removed, err
+		}
+		added = append(added, grant)
+	}
+	return added, removed, nil
+}
+
+// diffGrants returns the grants of roleName to add and to remove so that its data grants match
+// desired. Grants are compared case-insensitively for the resource type and privilege, duplicates
+// in desired are ignored, and both results are sorted.
+func diffGrants(roleName string, current, desired []Grant) (added, removed []Grant) {
+	currentKeys := make(map[string]bool, len(current))
+	for _, grant := range current {
+		grant.RoleName = roleName
+		currentKeys[grant.key()] = true
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, grant := range desired {
+		grant.RoleName = roleName
+		key := grant.key()
+		if !desiredKeys[key] && !currentKeys[key] {
+			added = append(added, grant)
+		}
+		desiredKeys[key] = true
+	}
+
+	for _, grant := range current {
+		grant.RoleName = roleName
+		if isDataResource(grant.ResourceType) && !desiredKeys[grant.key()] {
+			removed = append(removed, grant)
+		}
+	}
+
+	slices.SortFunc(added, CompareGrants)
+	slices.SortFunc(removed, CompareGrants)
+	return added, removed
+}
+
+// key identifies a grant case-insensitively for the resource type and privilege.
+func (g Grant) key() string {
+	return strings.Join([]string{
+		g.RoleName,
+		strings.ToUpper(g.ResourceType),
+		g.Keyspace,
+		g.Identifier,
+		strings.ToUpper(g.Privilege),
+	}, "|")
+}
+
+// CompareGrants orders grants by role, resource, and privilege, for use with slices.SortFunc.
+func CompareGrants(a, b Grant) int {
+	return strings.Compare(a.key(), b.key())
+}
+
+// AccessByKeyspace summarizes grants as the sorted privileges held on each keyspace. Privileges
+// granted on a table are merged into the entry of its keyspace; grants on ALL KEYSPACES and on
+// roles are not attributed to any keyspace and are left out.
+func AccessByKeyspace(grants []Grant) map[string][]string {
+	access := make(map[string][]string)
+	for _, grant := range grants {
+		switch strings.ToUpper(grant.ResourceType) {
+		case "KEYSPACE", "TABLE":
+		default:
+			continue
+		}
+		privilege := strings.ToUpper(grant.Privilege)
+		if !slices.Contains(access[grant.Keyspace], privilege) {
+			access[grant.Keyspace] = append(access[grant.Keyspace], privilege)
+		}
+	}
+	for _, privileges := range access {
+		slices.Sort(privileges)
+	}
+	return access
+}
+
+func isDataResource(resourceType string) bool {
+	switch strings.ToUpper(resourceType) {
+	case "ALL KEYSPACES", "KEYSPACE", "TABLE":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseResourceName is the inverse of getResourceName. It returns false for resources that
+// cannot be expressed as a Grant.
+func parseResourceName(resource string) (Grant, bool) {
+	parts := strings.Split(resource, "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "data":
+		return Grant{ResourceType: "ALL KEYSPACES"}, true
+	case len(parts) == 2 && parts[0] == "data":
+		return Grant{ResourceType: "KEYSPACE", Keyspace: parts[1]}, true
+	case len(parts) == 3 && parts[0] == "data":
+		return Grant{ResourceType: "TABLE", Keyspace: parts[1], Identifier: parts[2]}, true
+	case len(parts) == 1 && parts[0] == "roles":
+		return Grant{ResourceType: "ALL ROLES"}, true
+	case len(parts) == 2 && parts[0] == "roles":
+		return Grant{ResourceType: "ROLE", Keyspace: parts[1]}, true
+	default:
+		return Grant{}, false
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceName(t *testing.T) {
+	tests := []struct {
+		resource string
+		want     Grant
+		wantOK   bool
+	}{
+		{"data", Grant{ResourceType: "ALL KEYSPACES"}, true},
+		{"data/cycling", Grant{ResourceType: "KEYSPACE", Keyspace: "cycling"}, true},
+		{"data/cycling/cyclist_name", Grant{ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"}, true},
+		{"roles", Grant{ResourceType: "ALL ROLES"}, true},
+		{"roles/admin", Grant{ResourceType: "ROLE", Keyspace: "admin"}, true},
+		{"functions/cycling/avg", Grant{}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.resource, func(t *testing.T) {
+			got, ok := parseResourceName(tc.resource)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+			if ok {
+				assert.Equal(t, tc.resource, getResourceName(got))
+			}
+		})
+	}
+}
+
+func TestReconcileGrants(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	setupTestKSAndTable(t, cluster)
+	require.NoError(t, cluster.CreateRole(Role{Role: "role_grants"}))
+
+	// An extra grant created outside of the desired set
+	require.NoError(t, cluster.CreateGrant(Grant{
+		RoleName:     "role_grants",
+		Privilege:    "MODIFY",
+		ResourceType: "KEYSPACE",
+		Keyspace:     "cycling",
+	}))
+
+	desired := []Grant{
+		{Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{Privilege: "alter", ResourceType: "table", Keyspace: "cycling", Identifier: "cyclist_name"},
+	}
+	added, removed, err := cluster.ReconcileGrants("role_grants", desired)
+	require.NoError(t, err)
+	assert.Len(t, added, 2)
+	assert.Equal(t, []Grant{
+		{RoleName: "role_grants", Privilege: "MODIFY", ResourceType: "KEYSPACE", Keyspace: "cycling"},
+	}, removed)
+
+	grants, err := cluster.ListAllGrants("role_grants")
+	require.NoError(t, err)
+	assert.Equal(t, []Grant{
+		{RoleName: "role_grants", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{RoleName: "role_grants", Privilege: "ALTER", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"},
+	}, grants)
+
+	// Re-applying is a no-op
+	added, removed, err = cluster.ReconcileGrants("role_grants", desired)
+	require.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+	again, err := cluster.ListAllGrants("role_grants")
+	require.NoError(t, err)
+	assert.Equal(t, grants, again)
+
+	// A failing grant still reports the grants revoked before it
+	added, removed, err = cluster.ReconcileGrants("role_grants", []Grant{
+		{Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "no_such_keyspace"},
+	})
+	assert.Error(t, err)
+	assert.Empty(t, added)
+	assert.Equal(t, grants, removed)
+}
+
+func TestDiffGrants(t *testing.T) {
+	selectKS := Grant{RoleName: "r", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "ks"}
+	modifyKS := Grant{RoleName: "r", Privilege: "MODIFY", ResourceType: "KEYSPACE", Keyspace: "ks"}
+	alterTbl := Grant{RoleName: "r", Privilege: "ALTER", ResourceType: "TABLE", Keyspace: "ks", Identifier: "tbl"}
+	selectAll := Grant{RoleName: "r", Privilege: "SELECT", ResourceType: "ALL KEYSPACES"}
+	describeRoles := Grant{RoleName: "r", Privilege: "DESCRIBE", ResourceType: "ALL ROLES"}
+
+	tests := []struct {
+		name        string
+		current     []Grant
+		desired     []Grant
+		wantAdded   []Grant
+		wantRemoved []Grant
+	}{
+		{
+			name:      "grant everything from scratch",
+			desired:   []Grant{alterTbl, selectKS},
+			wantAdded: []Grant{selectKS, alterTbl},
+		},
+		{
+			name:    "already in sync",
+			current: []Grant{selectKS, alterTbl},
+			desired: []Grant{alterTbl, selectKS},
+		},
+		{
+			name:        "revoke extra and add missing",
+			current:     []Grant{selectKS, modifyKS},
+			desired:     []Grant{selectKS, selectAll},
+			wantAdded:   []Grant{selectAll},
+			wantRemoved: []Grant{modifyKS},
+		},
+		{
+			name:        "revoke everything",
+			current:     []Grant{selectKS, alterTbl},
+			wantRemoved: []Grant{selectKS, alterTbl},
+		},
+		{
+			name:    "non-data grants are left untouched",
+			current: []Grant{describeRoles, selectKS},
+			desired: []Grant{selectKS},
+		},
+		{
+			name:    "case-insensitive privileges and resource types",
+			current: []Grant{selectKS, alterTbl},
+			desired: []Grant{
+				{Privilege: "select", ResourceType: "keyspace", Keyspace: "ks"},
+				{Privilege: "Alter", ResourceType: "Table", Keyspace: "ks", Identifier: "tbl"},
+			},
+		},
+		{
+			name:      "duplicates in desired are ignored",
+			desired:   []Grant{selectKS, selectKS, {Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "ks"}},
+			wantAdded: []Grant{selectKS},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			added, removed := diffGrants("r", tc.current, tc.desired)
+			assert.Equal(t, tc.wantAdded, added)
+			assert.Equal(t, tc.wantRemoved, removed)
+
+			// The result does not depend on the input order
+			reversedAdded, reversedRemoved := diffGrants("r", reversed(tc.current), reversed(tc.desired))
+			assert.Equal(t, added, reversedAdded)
+			assert.Equal(t, removed, reversedRemoved)
+
+			// Applying the diff converges: diffing again yields nothing
+			converged := append(slices.DeleteFunc(slices.Clone(tc.current), func(g Grant) bool {
+				return slices.Contains(removed, g)
+			}), added...)
+			againAdded, againRemoved := diffGrants("r", converged, tc.desired)
+			assert.Empty(t, againAdded)
+			assert.Empty(t, againRemoved)
+		})
+	}
+}
+
+func TestAccessByKeyspace(t *testing.T) {
+	grants := []Grant{
+		{RoleName: "r", Privilege: "SELECT", ResourceType: "KEYSPACE", Keyspace: "cycling"},
+		{RoleName: "r", Privilege: "MODIFY", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "cyclist_name"},
+		{RoleName: "r", Privilege: "select", ResourceType: "TABLE", Keyspace: "cycling", Identifier: "race_times"},
+		{RoleName: "r", Privilege: "ALTER", ResourceType: "TABLE", Keyspace: "racing", Identifier: "riders"},
+		{RoleName: "r", Privilege: "SELECT", ResourceType: "ALL KEYSPACES"},
+		{RoleName: "r", Privilege: "DESCRIBE", ResourceType: "ROLE", Keyspace: "admin"},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"cycling": {"MODIFY", "SELECT"},
+		"racing":  {"ALTER"},
+	}, AccessByKeyspace(grants))
+	assert.Empty(t, AccessByKeyspace(nil))
+}
+
+func reversed(grants []Grant) []Grant {
+	grants = slices.Clone(grants)
+	slices.Reverse(grants)
+	return grants
+}
@@ -5,6 +5,9 @@ package scylladb
 import (
 	"errors"
 	"fmt"
+	"log"
+	"slices"
+	"strings"
 	"unicode"
 
 	gocql "github.com/apache/cassandra-gocql-driver/v2"
@@ -12,49 +15,381 @@ import (
 
 var ErrRoleNotFound = errors.New("role not found")
 
+// ErrRoleMembershipsIncomplete is returned by CreateRole when the role was created but granting
+// one of its memberships failed, so that the caller can track or drop the role.
+var ErrRoleMembershipsIncomplete = errors.New("role created without all of its memberships")
+
+// ErrSessionRoleLockout is returned by CheckSessionRoleChange when a change would take away the
+// login or superuser status of the role the provider is authenticated as.
+var ErrSessionRoleLockout = errors.New("change would lock out the authenticated role")
+
 type Role struct {
 	Role        string
 	CanLogin    bool
 	IsSuperuser bool
-	MemberOf    []string
+	// MemberOf lists the roles the role is a member of. When creating or updating a role, nil
+	// leaves its memberships alone, while any other value, including an empty slice, is applied
+	// with GRANT and REVOKE ROLE so that the role is a member of exactly these roles.
+	MemberOf []string
+	// HasPassword is set by GetRole when the role has a password. It is ignored when
+	// creating or updating a role.
+	HasPassword bool
+	// Password is set when creating or updating the role, unless empty. It is never read back,
+	// since ScyllaDB only stores a salted hash.
+	Password string
+}
+
+// options returns the WITH clause of CREATE ROLE and ALTER ROLE for role. ScyllaDB does not
+// accept bind markers in role statements, so the password is escaped as a string literal.
+func (role Role) options() string {
+	options := fmt.Sprintf("LOGIN = %v AND SUPERUSER = %v", role.CanLogin, role.IsSuperuser)
+	if role.Password != "" {
+		options += " AND PASSWORD = " + quoteLiteral(role.Password)
+	}
+	return options
 }
 
 func (c *Cluster) GetRole(roleName string) (Role, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
 	var role Role
-	query := fmt.Sprintf("SELECT role, can_login, is_superuser, member_of FROM %s.roles WHERE role = ?", c.SystemAuthKeyspaceName)
-	if err := c.Session.Query(query, roleName).Scan(
+	// The salted hash is only read to tell whether a password is set, and is discarded.
+	var saltedHash *string
+	query := fmt.Sprintf("SELECT role, can_login, is_superuser, member_of, salted_hash FROM %s.roles WHERE role = ?", c.SystemAuthKeyspaceName)
+	if err := c.ReadSession().Query(query, roleName).ScanContext(ctx,
 		&role.Role,
 		&role.CanLogin,
 		&role.IsSuperuser,
 		&role.MemberOf,
+		&saltedHash,
 	); err != nil {
 		if errors.Is(err, gocql.ErrNotFound) {
 			return Role{}, ErrRoleNotFound
 		}
 		return Role{}, err
 	}
+	role.HasPassword = saltedHash != nil && *saltedHash != ""
 	return role, nil
 }
 
+// ListRoles returns all roles, sorted by name.
+func (c *Cluster) ListRoles() ([]Role, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	query := fmt.Sprintf("SELECT role, can_login, is_superuser, member_of FROM %s.roles", c.SystemAuthKeyspaceName)
+	iter := c.ReadSession().Query(query).IterContext(ctx)
+	var roles []Role
+	var role Role
+	for iter.Scan(&role.Role, &role.CanLogin, &role.IsSuperuser, &role.MemberOf) {
+		roles = append(roles, role)
+		role = Role{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(roles, func(a, b Role) int { return strings.Compare(a.Role, b.Role) })
+	return roles, nil
+}
+
+// GetRoleMembers returns the roles that have been granted roleName, sorted by name. It is the
+// inverse of Role.MemberOf.
+func (c *Cluster) GetRoleMembers(roleName string) ([]string, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	query := fmt.Sprintf("SELECT member FROM %s.role_members WHERE role = ?", c.SystemAuthKeyspaceName)
+	iter := c.ReadSession().Query(query, roleName).IterContext(ctx)
+	var members []string
+	var member string
+	for iter.Scan(&member) {
+		members = append(members, member)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.Sort(members)
+	return members, nil
+}
+
+// CreateRole creates the role and grants it the roles of MemberOf. When a grant fails, the role
+// is left in place and the error wraps ErrRoleMembershipsIncomplete.
 func (c *Cluster) CreateRole(role Role) error {
 	if err := validateRoleName(role.Role); err != nil {
 		return err
 	}
-	query := fmt.Sprintf(`CREATE ROLE '%s' WITH LOGIN = %v AND SUPERUSER = %v`, role.Role, role.CanLogin, role.IsSuperuser)
-	return c.Session.Query(query).Exec()
+	name, err := roleIdentifier(role.Role)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`CREATE ROLE %s%s WITH %s`, c.ifNotExists(false), name, role.options())
+	exec := c.exec
+	if c.idempotentDDL(false) {
+		// A retry after the role was created only succeeds with IF NOT EXISTS
+		exec = c.execIdempotent
+	}
+	if err := exec(query); err != nil {
+		return err
+	}
+	// The role is not dropped on failure, since IF NOT EXISTS may have kept an existing role
+	if _, _, err := c.applyMemberships(role.Role, nil, role.MemberOf); err != nil {
+		return fmt.Errorf("%w: %w", ErrRoleMembershipsIncomplete, err)
+	}
+	return nil
 }
 
 func (c *Cluster) UpdateRole(role Role) error {
-	query := fmt.Sprintf(`ALTER ROLE '%s' WITH LOGIN = %v AND SUPERUSER = %v`, role.Role, role.CanLogin, role.IsSuperuser)
-	return c.Session.Query(query).Exec()
+	if err := c.alterRole(role); err != nil {
+		return err
+	}
+	if role.MemberOf == nil {
+		return nil
+	}
+	_, _, err := c.ReconcileRoleMemberships(role.Role, role.MemberOf)
+	return err
+}
+
+// alterRole sets the login, superuser, and password options of role, leaving its memberships alone.
+func (c *Cluster) alterRole(role Role) error {
+	name, err := roleIdentifier(role.Role)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`ALTER ROLE %s WITH %s`, name, role.options())
+	return c.exec(query)
+}
+
+// UpdateRoleIfChanged updates the role like UpdateRole, but only issues the ALTER when the login
+// or superuser attributes of the role differ from role, or role sets a password, which cannot be
+// compared, and only grants and revokes the memberships that differ. It reports whether the role
+// was altered.
+func (c *Cluster) UpdateRoleIfChanged(role Role) (altered bool, err error) {
+	current, err := c.GetRole(role.Role)
+	if err != nil {
+		return false, err
+	}
+	if current.CanLogin != role.CanLogin || current.IsSuperuser != role.IsSuperuser || role.Password != "" {
+		if err := c.alterRole(role); err != nil {
+			return false, err
+		}
+		altered = true
+	}
+	if role.MemberOf != nil {
+		added, removed, err := c.applyMemberships(role.Role, current.MemberOf, role.MemberOf)
+		if err != nil {
+			return altered, err
+		}
+		altered = altered || len(added) > 0 || len(removed) > 0
+	}
+	return altered, nil
+}
+
+// SessionRole returns the role the session authenticates as, or "" when the cluster does not use
+// password authentication.
+func (c *Cluster) SessionRole() string {
+	if authenticator, ok := c.Cluster.Authenticator.(gocql.PasswordAuthenticator); ok {
+		return authenticator.Username
+	}
+	return ""
+}
+
+// CheckSessionRoleChange returns an error wrapping ErrSessionRoleLockout when current is the
+// role the session authenticates as and changing it to desired would drop its login or superuser
+// status, or revoke the memberships that give it superuser status or AUTHORIZE when nothing else
+// does. A nil desired means the role is dropped, and a nil desired.MemberOf leaves memberships
+// alone. The rest of the apply runs as that role, so such a change makes later operations fail
+// with confusing authorization errors.
+func (c *Cluster) CheckSessionRoleChange(current Role, desired *Role) error {
+	if sessionRole := c.SessionRole(); sessionRole == "" || sessionRole != current.Role {
+		return nil
+	}
+	var lost []string
+	switch {
+	case desired == nil:
+		lost = append(lost, "the role itself")
+	default:
+		if current.CanLogin && !desired.CanLogin {
+			lost = append(lost, "login")
+		}
+		if current.IsSuperuser && !desired.IsSuperuser {
+			lost = append(lost, "superuser")
+		}
+		if desired.MemberOf != nil && !desired.IsSuperuser {
+			revoked, err := c.revokedAuthority(current.Role, desired.MemberOf, current.MemberOf)
+			if err != nil {
+				return err
+			}
+			if len(revoked) > 0 {
+				lost = append(lost, "the authority granted through "+strings.Join(revoked, ", "))
+			}
+		}
+	}
+	if len(lost) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: the provider is authenticated as %s and the change removes %s",
+		ErrSessionRoleLockout, current.Role, strings.Join(lost, " and "))
+}
+
+// revokedAuthority returns the parents of roleName that are revoked by changing its memberships
+// from current to desired and give it superuser status or AUTHORIZE, unless the role keeps them
+// through a grant of its own or through the remaining parents.
+func (c *Cluster) revokedAuthority(roleName string, desired, current []string) ([]string, error) {
+	_, removed := diffMemberships(current, desired)
+	var revoked []string
+	for _, parent := range removed {
+		authority, err := c.hasAuthority(parent, true)
+		if err != nil {
+			return nil, err
+		}
+		if authority {
+			revoked = append(revoked, parent)
+		}
+	}
+	if len(revoked) == 0 {
+		return nil, nil
+	}
+	kept, err := c.hasAuthority(roleName, false)
+	if err != nil || kept {
+		return nil, err
+	}
+	for _, parent := range desired {
+		if kept, err := c.hasAuthority(parent, true); err != nil || kept {
+			return nil, err
+		}
+	}
+	return revoked, nil
+}
+
+// hasAuthority reports whether roleName holds AUTHORIZE on some resource. With inherited, the
+// roles it is a member of, directly or transitively, are taken into account, and a superuser
+// among them counts as authority; otherwise only the grants of roleName itself are.
+func (c *Cluster) hasAuthority(roleName string, inherited bool) (bool, error) {
+	if inherited {
+		if _, superuser, err := c.inheritedRoles(roleName); err != nil || superuser {
+			return superuser, err
+		}
+	}
+	permissions, err := c.ListRolePermissions(roleName, inherited)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(permissions, func(p Permission) bool {
+		return strings.EqualFold(p.Permission, "AUTHORIZE")
+	}), nil
+}
+
+// ReconcileRoleMemberships makes the roles roleName is a member of exactly match parents: roles
+// it was granted that are not listed are revoked and missing ones are granted. It returns the
+// memberships that were added and removed, sorted.
+func (c *Cluster) ReconcileRoleMemberships(roleName string, parents []string) (added, removed []string, err error) {
+	current, err := c.GetRole(roleName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.applyMemberships(roleName, current.MemberOf, parents)
+}
+
+// applyMemberships issues the GRANT and REVOKE ROLE statements that turn the current memberships
+// of roleName into desired, and returns the memberships that were added and removed, sorted.
+func (c *Cluster) applyMemberships(roleName string, current, desired []string) (added, removed []string, err error) {
+	added, removed = diffMemberships(current, desired)
+	for _, parent := range removed {
+		if err := c.exec(fmt.Sprintf(`REVOKE %s FROM %s`, QuoteIdentifier(parent), QuoteIdentifier(roleName))); err != nil {
+			return nil, nil, fmt.Errorf("failed to revoke role %s from %s: %w", parent, roleName, err)
+		}
+	}
+	var granted []string
+	for _, parent := range added {
+		if err := c.exec(fmt.Sprintf(`GRANT %s TO %s`, QuoteIdentifier(parent), QuoteIdentifier(roleName))); err != nil {
+			// current may be stale, e.g. when CREATE ROLE IF NOT EXISTS adopted an existing role
+			if isAlreadyMemberError(err) {
+				log.Printf("Role %s is already a member of %s", roleName, parent)
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to grant role %s to %s: %w", parent, roleName, err)
+		}
+		granted = append(granted, parent)
+	}
+	return granted, removed, nil
+}
+
+// alreadyMemberMessages are the messages with which ScyllaDB and Cassandra reject granting a role
+// to a role that is already a member of it.
+var alreadyMemberMessages = []string{"already includes role", "is a member of"}
+
+// isAlreadyMemberError reports whether err means that a GRANT ROLE found the membership already
+// in place.
+func isAlreadyMemberError(err error) bool {
+	var requestErr gocql.RequestError
+	return errors.As(err, &requestErr) && requestErr.Code() == gocql.ErrCodeInvalid &&
+		containsAny(strings.ToLower(requestErr.Message()), alreadyMemberMessages)
+}
+
+// diffMemberships returns the sorted parents that are desired but not current, and those that
+// are current but not desired.
+func diffMemberships(current, desired []string) (added, removed []string) {
+	for _, parent := range desired {
+		if !slices.Contains(current, parent) && !slices.Contains(added, parent) {
+			added = append(added, parent)
+		}
+	}
+	for _, parent := range current {
+		if !slices.Contains(desired, parent) {
+			removed = append(removed, parent)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
 }
 
 func (c *Cluster) DeleteRole(role Role) error {
-	query := fmt.Sprintf(`DROP ROLE '%s'`, role.Role)
-	return c.Session.Query(query).Exec()
+	name, err := roleIdentifier(role.Role)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`DROP ROLE %s%s`, c.ifExists(false), name)
+	return c.exec(query)
+}
+
+// VerifyLogin checks that username and password can log in by opening a separate session with
+// the cluster's connection settings (hosts, proxy, TLS) and running a trivial query. The
+// cluster's own session is not affected.
+func (c *Cluster) VerifyLogin(username, password string) error {
+	config := *c.Cluster
+	config.PoolConfig.HostSelectionPolicy = c.newHostSelectionPolicy()
+	config.Authenticator = gocql.PasswordAuthenticator{
+		Username: username,
+		Password: password,
+	}
+	session, err := config.CreateSession()
+	if err != nil {
+		return fmt.Errorf("failed to log in as %s: %w", username, err)
+	}
+	defer session.Close()
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	var releaseVersion string
+	if err := session.Query("SELECT release_version FROM system.local").ScanContext(ctx, &releaseVersion); err != nil {
+		return fmt.Errorf("logged in as %s but failed to run a query: %w", username, err)
+	}
+	return nil
+}
+
+// roleIdentifier returns name quoted for use in CREATE, ALTER, and DROP ROLE statements, which do
+// not accept bind markers for the role name. Quoting keeps any name within the identifier, so
+// roles created outside Terraform with names CreateRole rejects can still be altered and dropped.
+func roleIdentifier(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("role name must not be empty")
+	}
+	return QuoteIdentifier(name), nil
 }
 
 func validateRoleName(name string) error {
+	if name == "" {
+		return errors.New("role name must not be empty")
+	}
 	// Only allow alphanumeric and underscore
 	for _, r := range name {
 		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
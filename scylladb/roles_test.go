@@ -3,11 +3,16 @@
 package scylladb
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // newTestCluster creates a Cluster connected to a test ScyllaDB container.
@@ -47,11 +52,36 @@ func TestGetRoleCassandra(t *testing.T) {
 		CanLogin:    true,
 		IsSuperuser: true,
 		MemberOf:    nil,
+		HasPassword: true,
 	}
 
 	assert.Equal(t, expectedRole, role)
 }
 
+// TestGetRoleOtherAuthKeyspace verifies that a cluster returned by WithSystemAuthKeyspace reads
+// roles from the given keyspace, while the original cluster keeps reading its own.
+func TestGetRoleOtherAuthKeyspace(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.CreateKeyspace(Keyspace{Name: "tenant_auth", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true}))
+	require.NoError(t, cluster.execDDL(`CREATE TABLE tenant_auth.roles (role text PRIMARY KEY, can_login boolean, is_superuser boolean, member_of set<text>, salted_hash text)`))
+	require.NoError(t, cluster.AwaitSchemaAgreement())
+	require.NoError(t, cluster.Session.Query(`INSERT INTO tenant_auth.roles (role, can_login, is_superuser, member_of) VALUES (?, ?, ?, ?)`,
+		"tenant_role", true, false, []string{"tenant_parent"}).Exec())
+
+	tenant := cluster.WithSystemAuthKeyspace("tenant_auth")
+	role, err := tenant.GetRole("tenant_role")
+	require.NoError(t, err)
+	assert.Equal(t, Role{Role: "tenant_role", CanLogin: true, MemberOf: []string{"tenant_parent"}}, role)
+
+	_, err = cluster.GetRole("tenant_role")
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+	assert.Equal(t, "system", cluster.SystemAuthKeyspaceName)
+	assert.Same(t, cluster, cluster.WithSystemAuthKeyspace(""))
+	assert.Same(t, cluster, cluster.WithSystemAuthKeyspace("system"))
+}
+
 func TestCreateRole(t *testing.T) {
 	cluster := newTestCluster(t)
 	defer cluster.Session.Close()
@@ -143,3 +173,366 @@ func TestDeleteRole(t *testing.T) {
 	_, err = cluster.GetRole(inputRole.Role)
 	assert.ErrorIs(t, err, ErrRoleNotFound)
 }
+
+func TestListRoles(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"list_b", "list_a"} {
+		if err := cluster.CreateRole(Role{Role: name, CanLogin: name == "list_a"}); err != nil {
+			t.Fatalf("failed to create a role: %s", err)
+		}
+	}
+
+	roles, err := cluster.ListRoles()
+	if err != nil {
+		t.Fatalf("failed to list roles: %s", err)
+	}
+
+	assert.Equal(t, []Role{
+		{Role: "cassandra", CanLogin: true, IsSuperuser: true},
+		{Role: "list_a", CanLogin: true},
+		{Role: "list_b"},
+	}, roles)
+}
+
+func TestRoleOptions(t *testing.T) {
+	assert.Equal(t, "LOGIN = true AND SUPERUSER = false", Role{Role: "r", CanLogin: true}.options())
+	assert.Equal(t, "LOGIN = true AND SUPERUSER = false AND PASSWORD = 'it''s secret'",
+		Role{Role: "r", CanLogin: true, Password: "it's secret"}.options())
+}
+
+// TestRoleInjection verifies that CreateRole rejects role names that would escape the identifier
+// before any query is run, that ALTER and DROP ROLE keep any name within its quoted identifier,
+// and that passwords are kept within their string literal.
+func TestRoleInjection(t *testing.T) {
+	cluster := &Cluster{}
+	for _, name := range []string{
+		`x" WITH SUPERUSER = true; --`,
+		`x WITH SUPERUSER = true`,
+		`x'; DROP KEYSPACE system_auth; --`,
+		"",
+	} {
+		assert.Error(t, cluster.CreateRole(Role{Role: name, CanLogin: true}), name)
+	}
+	assert.Error(t, cluster.UpdateRole(Role{}))
+	assert.Error(t, cluster.DeleteRole(Role{}))
+
+	name, err := roleIdentifier("My_Role")
+	require.NoError(t, err)
+	assert.Equal(t, `"My_Role"`, name)
+	name, err = roleIdentifier(`x" WITH SUPERUSER = true; --`)
+	require.NoError(t, err)
+	assert.Equal(t, `"x"" WITH SUPERUSER = true; --"`, name)
+	assert.Equal(t, "LOGIN = true AND SUPERUSER = false AND PASSWORD = 'x'' AND SUPERUSER = true AND PASSWORD = ''y'",
+		Role{Role: "r", CanLogin: true, Password: "x' AND SUPERUSER = true AND PASSWORD = 'y"}.options())
+}
+
+// TestRoleWithUnusualName verifies that a role whose name CreateRole rejects, as created outside
+// Terraform, can still be updated and dropped.
+func TestRoleWithUnusualName(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.Session.Query(`CREATE ROLE "app-reader.v2"`).Exec())
+	require.NoError(t, cluster.UpdateRole(Role{Role: "app-reader.v2", CanLogin: true}))
+	role, err := cluster.GetRole("app-reader.v2")
+	require.NoError(t, err)
+	assert.True(t, role.CanLogin)
+	require.NoError(t, cluster.DeleteRole(Role{Role: "app-reader.v2"}))
+	_, err = cluster.GetRole("app-reader.v2")
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
+
+func TestVerifyLogin(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	if err := cluster.CreateRole(Role{Role: "login_role", CanLogin: true, Password: "login_password"}); err != nil {
+		t.Fatalf("failed to create a role: %s", err)
+	}
+
+	assert.NoError(t, cluster.VerifyLogin("login_role", "login_password"))
+	assert.Error(t, cluster.VerifyLogin("login_role", "wrong_password"))
+
+	altered, err := cluster.UpdateRoleIfChanged(Role{Role: "login_role", CanLogin: true, Password: "rotated_password"})
+	require.NoError(t, err)
+	assert.True(t, altered)
+	assert.NoError(t, cluster.VerifyLogin("login_role", "rotated_password"))
+	assert.Error(t, cluster.VerifyLogin("login_role", "login_password"))
+
+	// The main session is still usable
+	_, err = cluster.GetRole("login_role")
+	assert.NoError(t, err)
+}
+
+func TestGetRoleHasPassword(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.Session.Query(`CREATE ROLE service_account WITH PASSWORD = 's3cret' AND LOGIN = true`).Exec())
+	require.NoError(t, cluster.CreateRole(Role{Role: "grouping_role"}))
+
+	serviceAccount, err := cluster.GetRole("service_account")
+	require.NoError(t, err)
+	assert.True(t, serviceAccount.HasPassword)
+
+	groupingRole, err := cluster.GetRole("grouping_role")
+	require.NoError(t, err)
+	assert.False(t, groupingRole.HasPassword)
+}
+
+func TestGetRoleMembers(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"parent_role", "member_b", "member_a"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	for _, member := range []string{"member_b", "member_a"} {
+		require.NoError(t, cluster.Session.Query(fmt.Sprintf(`GRANT parent_role TO %s`, member)).Exec())
+	}
+
+	members, err := cluster.GetRoleMembers("parent_role")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member_a", "member_b"}, members)
+
+	// Membership is one-directional
+	members, err = cluster.GetRoleMembers("member_a")
+	require.NoError(t, err)
+	assert.Empty(t, members)
+
+	role, err := cluster.GetRole("member_a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"parent_role"}, role.MemberOf)
+}
+
+// alterCounter counts the ALTER statements run on a session.
+type alterCounter struct {
+	alters atomic.Int32
+}
+
+func (o *alterCounter) ObserveQuery(_ context.Context, q gocql.ObservedQuery) {
+	if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(q.Statement)), "ALTER") {
+		o.alters.Add(1)
+	}
+}
+
+func TestUpdateRoleIfChanged(t *testing.T) {
+	host := testutil.NewTestContainer(t)
+	cluster, err := NewClusterConfig([]string{host})
+	require.NoError(t, err)
+	cluster.SetSystemAuthKeyspace("system")
+	cluster.SetUserPasswordAuth("cassandra", "cassandra")
+	observer := &alterCounter{}
+	cluster.Cluster.QueryObserver = observer
+	require.NoError(t, cluster.CreateSession())
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.CreateRole(Role{Role: "unchanged_role", CanLogin: true}))
+
+	// A no-op update does not run an ALTER
+	altered, err := cluster.UpdateRoleIfChanged(Role{Role: "unchanged_role", CanLogin: true})
+	require.NoError(t, err)
+	assert.False(t, altered)
+	assert.Zero(t, observer.alters.Load())
+
+	altered, err = cluster.UpdateRoleIfChanged(Role{Role: "unchanged_role", CanLogin: false})
+	require.NoError(t, err)
+	assert.True(t, altered)
+	assert.EqualValues(t, 1, observer.alters.Load())
+	role, err := cluster.GetRole("unchanged_role")
+	require.NoError(t, err)
+	assert.False(t, role.CanLogin)
+
+	// Memberships are applied without an ALTER, and only when they differ
+	require.NoError(t, cluster.CreateRole(Role{Role: "unchanged_parent"}))
+	altered, err = cluster.UpdateRoleIfChanged(Role{Role: "unchanged_role", MemberOf: []string{"unchanged_parent"}})
+	require.NoError(t, err)
+	assert.True(t, altered)
+	altered, err = cluster.UpdateRoleIfChanged(Role{Role: "unchanged_role", MemberOf: []string{"unchanged_parent"}})
+	require.NoError(t, err)
+	assert.False(t, altered)
+	assert.EqualValues(t, 1, observer.alters.Load())
+	altered, err = cluster.UpdateRoleIfChanged(Role{Role: "unchanged_role", MemberOf: []string{}})
+	require.NoError(t, err)
+	assert.True(t, altered)
+	role, err = cluster.GetRole("unchanged_role")
+	require.NoError(t, err)
+	assert.Empty(t, role.MemberOf)
+
+	_, err = cluster.UpdateRoleIfChanged(Role{Role: "it_should_not_exist"})
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
+
+// TestCreateRoleMissingParent verifies that CreateRole reports a role created without one of its
+// memberships with ErrRoleMembershipsIncomplete, and leaves the role in place.
+func TestCreateRoleMissingParent(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	err := cluster.CreateRole(Role{Role: "orphan_app", MemberOf: []string{"no_such_parent"}})
+	assert.ErrorIs(t, err, ErrRoleMembershipsIncomplete)
+	role, err := cluster.GetRole("orphan_app")
+	require.NoError(t, err)
+	assert.Empty(t, role.MemberOf)
+}
+
+// TestRoleMemberOf verifies that CreateRole grants the roles of MemberOf, and UpdateRole makes
+// the role a member of exactly them unless MemberOf is nil.
+func TestRoleMemberOf(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"member_readers", "member_writers"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	require.NoError(t, cluster.CreateRole(Role{Role: "member_app", MemberOf: []string{"member_writers", "member_readers"}}))
+	role, err := cluster.GetRole("member_app")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member_readers", "member_writers"}, role.MemberOf)
+
+	require.NoError(t, cluster.UpdateRole(Role{Role: "member_app", CanLogin: true}))
+	role, err = cluster.GetRole("member_app")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member_readers", "member_writers"}, role.MemberOf)
+
+	require.NoError(t, cluster.UpdateRole(Role{Role: "member_app", CanLogin: true, MemberOf: []string{"member_readers"}}))
+	role, err = cluster.GetRole("member_app")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member_readers"}, role.MemberOf)
+}
+
+func TestCheckSessionRoleChange(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	admin := Role{Role: "admin", CanLogin: true, IsSuperuser: true}
+
+	// Without password authentication there is no session role to protect
+	assert.NoError(t, cluster.CheckSessionRoleChange(admin, nil))
+
+	cluster.SetUserPasswordAuth("admin", "secret")
+	assert.NoError(t, cluster.CheckSessionRoleChange(admin, &admin))
+	assert.NoError(t, cluster.CheckSessionRoleChange(Role{Role: "app", CanLogin: true}, nil))
+
+	err = cluster.CheckSessionRoleChange(admin, &Role{Role: "admin", CanLogin: false, IsSuperuser: true})
+	assert.ErrorIs(t, err, ErrSessionRoleLockout)
+	assert.ErrorContains(t, err, "removes login")
+
+	err = cluster.CheckSessionRoleChange(admin, &Role{Role: "admin"})
+	assert.ErrorContains(t, err, "removes login and superuser")
+
+	err = cluster.CheckSessionRoleChange(admin, nil)
+	assert.ErrorIs(t, err, ErrSessionRoleLockout)
+	assert.ErrorContains(t, err, "removes the role itself")
+}
+
+// TestCheckSessionRoleChangeMembership verifies that revoking the membership the session role
+// gets superuser status or AUTHORIZE from is refused, unless another membership keeps it.
+func TestCheckSessionRoleChangeMembership(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	require.NoError(t, cluster.CreateRole(Role{Role: "lockout_admins", IsSuperuser: true}))
+	require.NoError(t, cluster.CreateRole(Role{Role: "lockout_authorizers"}))
+	require.NoError(t, cluster.CreateGrant(Grant{Privilege: "AUTHORIZE", ResourceType: "ALL KEYSPACES", RoleName: "lockout_authorizers"}))
+	require.NoError(t, cluster.CreateRole(Role{Role: "lockout_readers"}))
+	current := Role{Role: "lockout_operator", CanLogin: true, MemberOf: []string{"lockout_admins", "lockout_authorizers", "lockout_readers"}}
+	require.NoError(t, cluster.CreateRole(current))
+	// Only the name of the session role is compared, so the open session is kept
+	cluster.SetUserPasswordAuth("lockout_operator", "secret")
+
+	desired := current
+	desired.MemberOf = []string{"lockout_admins", "lockout_authorizers"}
+	assert.NoError(t, cluster.CheckSessionRoleChange(current, &desired), "lockout_readers gives no authority")
+	desired.MemberOf = []string{"lockout_authorizers"}
+	assert.NoError(t, cluster.CheckSessionRoleChange(current, &desired), "lockout_authorizers keeps AUTHORIZE")
+	desired.MemberOf = nil
+	assert.NoError(t, cluster.CheckSessionRoleChange(current, &desired), "memberships are left alone")
+
+	desired.MemberOf = []string{"lockout_readers"}
+	err := cluster.CheckSessionRoleChange(current, &desired)
+	assert.ErrorIs(t, err, ErrSessionRoleLockout)
+	assert.ErrorContains(t, err, "removes the authority granted through lockout_admins, lockout_authorizers")
+	desired.IsSuperuser = true
+	assert.NoError(t, cluster.CheckSessionRoleChange(current, &desired), "the role becomes a superuser itself")
+}
+
+func TestDiffMemberships(t *testing.T) {
+	added, removed := diffMemberships([]string{"readers", "writers"}, []string{"auditors", "readers", "auditors"})
+	assert.Equal(t, []string{"auditors"}, added)
+	assert.Equal(t, []string{"writers"}, removed)
+
+	added, removed = diffMemberships([]string{"readers"}, []string{"readers"})
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	added, removed = diffMemberships([]string{"writers", "readers"}, nil)
+	assert.Empty(t, added)
+	assert.Equal(t, []string{"readers", "writers"}, removed)
+}
+
+func TestReconcileRoleMemberships(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"readers", "writers", "auditors", "member"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	require.NoError(t, cluster.Session.Query(`GRANT writers TO member`).Exec())
+
+	added, removed, err := cluster.ReconcileRoleMemberships("member", []string{"readers", "auditors"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"auditors", "readers"}, added)
+	assert.Equal(t, []string{"writers"}, removed)
+
+	role, err := cluster.GetRole("member")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"auditors", "readers"}, role.MemberOf)
+
+	// Reconciling again changes nothing
+	added, removed, err = cluster.ReconcileRoleMemberships("member", []string{"auditors", "readers"})
+	require.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+}
+
+// requestError is a gocql.RequestError with a given code and message.
+type requestError struct {
+	code    int
+	message string
+}
+
+func (e requestError) Code() int       { return e.code }
+func (e requestError) Message() string { return e.message }
+func (e requestError) Error() string   { return e.message }
+
+func TestIsAlreadyMemberError(t *testing.T) {
+	assert.True(t, isAlreadyMemberError(requestError{gocql.ErrCodeInvalid, "member already includes role readers."}))
+	assert.True(t, isAlreadyMemberError(fmt.Errorf("wrapped: %w", requestError{gocql.ErrCodeInvalid, "member is a member of readers"})))
+	assert.False(t, isAlreadyMemberError(requestError{gocql.ErrCodeInvalid, "member is not a member of readers"}))
+	assert.False(t, isAlreadyMemberError(requestError{gocql.ErrCodeUnauthorized, "member already includes role readers."}))
+	assert.False(t, isAlreadyMemberError(fmt.Errorf("member already includes role readers")))
+}
+
+// TestGrantMembershipTwice verifies that granting a membership the role already holds, as when
+// the memberships read before the grant are stale, succeeds without reporting it as added.
+func TestGrantMembershipTwice(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	for _, name := range []string{"twice_readers", "twice_member"} {
+		require.NoError(t, cluster.CreateRole(Role{Role: name}))
+	}
+	added, _, err := cluster.applyMemberships("twice_member", nil, []string{"twice_readers"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"twice_readers"}, added)
+
+	added, _, err = cluster.applyMemberships("twice_member", nil, []string{"twice_readers"})
+	require.NoError(t, err)
+	assert.Empty(t, added)
+
+	role, err := cluster.GetRole("twice_member")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"twice_readers"}, role.MemberOf)
+}
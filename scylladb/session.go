@@ -3,6 +3,7 @@
 package scylladb
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -11,6 +12,8 @@ import (
 	"log"
 	"net"
 	"net/url"
+	"strings"
+	"time"
 
 	gocql "github.com/apache/cassandra-gocql-driver/v2"
 	"golang.org/x/net/proxy"
@@ -20,6 +23,30 @@ type Cluster struct {
 	Cluster                *gocql.ClusterConfig
 	SystemAuthKeyspaceName string
 	Session                *gocql.Session
+	// RequestTimeout bounds regular queries and DDLTimeout bounds schema-altering statements.
+	RequestTimeout time.Duration
+	DDLTimeout     time.Duration
+	// RequireDestroyConfirmation makes resource deletes conditional on explicit confirmation.
+	RequireDestroyConfirmation bool
+	// GrantVerifyAttempts bounds how often ReadGrantPermissions reads a new grant.
+	GrantVerifyAttempts int
+	// DDLMode controls whether CREATE and DROP statements include IF [NOT] EXISTS.
+	DDLMode DDLMode
+	// ConnectConcurrency bounds the connections established and host names resolved at the same
+	// time; zero means no bound. See SetConnectConcurrency.
+	ConnectConcurrency int
+
+	idle         *idleTracker
+	readFallback *readFallback
+	readConfig   *readSessionConfig
+	readSession  *gocql.Session
+	localDC      string
+	failover     bool
+	resolver     *net.Resolver
+	coordinator  *coordinatorPin
+	metrics      *queryMetrics
+	// ctx bounds the queries of the cluster as well as their timeouts; see WithContext.
+	ctx context.Context
 }
 
 type ProxyHostDialer struct {
@@ -115,8 +142,20 @@ func NewClusterConfig(hosts []string) (newCluster *Cluster, err error) {
 func NewClusterConfigWithProxy(hosts []string, proxyAddr string) (newCluster *Cluster, err error) {
 	var clusterHostDialer gocql.HostDialer
 
+	// A Unix socket is dialed directly and never through a proxy
+	unixSocketDialer, hosts, err := getUnixSocketDialer(hosts)
+	if err != nil {
+		return nil, err
+	}
+	if unixSocketDialer != nil {
+		if proxyAddr != "" {
+			return nil, errors.New("a proxy cannot be used with a Unix socket host")
+		}
+		clusterHostDialer = unixSocketDialer
+	}
+
 	// if proxyAddr is not provided as an argument, check environment variables
-	if proxyAddr == "" {
+	if proxyAddr == "" && unixSocketDialer == nil {
 		proxyAddr = httpProxyEnv.Get()
 	}
 	if proxyAddr != "" {
@@ -135,21 +174,127 @@ func NewClusterConfigWithProxy(hosts []string, proxyAddr string) (newCluster *Cl
 	}
 	cluster.DisableInitialHostLookup = true
 	cluster.NumConns = 1
-	return &Cluster{
+	newCluster = &Cluster{
 		Cluster:                cluster,
 		SystemAuthKeyspaceName: "system_auth",
-	}, nil
+		GrantVerifyAttempts:    DefaultGrantVerifyAttempts,
+		resolver:               &net.Resolver{},
+		metrics:                &queryMetrics{},
+	}
+	newCluster.SetTimeouts(DefaultRequestTimeout, DefaultDDLTimeout)
+	return newCluster, nil
 }
 
+// CreateSession connects to the cluster. Failures caused by the credentials, the TLS handshake, or
+// unreachable hosts wrap ErrAuthFailed, ErrTLS, and ErrUnreachable respectively.
 func (c *Cluster) CreateSession() error {
-	session, err := c.Cluster.CreateSession()
-	if err != nil {
+	ctx, cancel := timeoutContext(c.operationContext(), c.Cluster.ConnectTimeout)
+	defer cancel()
+	if err := c.resolveContactPoints(ctx); err != nil {
 		return err
 	}
+	c.metrics.observe(c.Cluster)
+	session, err := c.connectConfig().CreateSession()
+	if err != nil {
+		return classifySessionError(err)
+	}
 	c.Session = session
+	if err := c.createReadSession(); err != nil {
+		session.Close()
+		c.Session = nil
+		return err
+	}
 	return nil
 }
 
+// EffectiveConfig summarizes the cluster configuration as it will be used to connect, for
+// logging. Secrets (passwords, private keys) are never included.
+func (c *Cluster) EffectiveConfig() map[string]any {
+	config := map[string]any{
+		"hosts":                       c.Cluster.Hosts,
+		"num_conns":                   c.Cluster.NumConns,
+		"connect_concurrency":         c.ConnectConcurrency,
+		"consistency":                 c.Cluster.Consistency.String(),
+		"request_timeout":             c.RequestTimeout.String(),
+		"ddl_timeout":                 c.DDLTimeout.String(),
+		"grant_verify_attempts":       c.GrantVerifyAttempts,
+		"ddl_mode":                    c.DDLMode.String(),
+		"connect_timeout":             c.Cluster.ConnectTimeout.String(),
+		"disable_initial_host_lookup": c.Cluster.DisableInitialHostLookup,
+		"system_auth_keyspace":        c.SystemAuthKeyspaceName,
+		"host_filter":                 c.Cluster.HostFilter != nil,
+		"proxy":                       false,
+		"tls":                         c.Cluster.SslOpts != nil,
+		"auth":                        "none",
+	}
+
+	// With a proxy, the contact points are dummy hosts and DNS is resolved by the proxy.
+	if proxyHostDialer, ok := c.Cluster.HostDialer.(*ProxyHostDialer); ok {
+		realHosts := make([]string, 0, len(proxyHostDialer.hostMap))
+		for _, dummyHost := range c.Cluster.Hosts {
+			realHosts = append(realHosts, proxyHostDialer.hostMap[dummyHost])
+		}
+		config["proxy"] = true
+		config["dns_through_proxy"] = true
+		config["proxied_hosts"] = realHosts
+	}
+
+	if dialer, ok := c.Cluster.Dialer.(*net.Dialer); ok {
+		config["dial_timeout"] = dialer.Timeout.String()
+		config["dual_stack"] = dialer.FallbackDelay >= 0
+		if dialer.LocalAddr != nil {
+			config["local_addr"] = dialer.LocalAddr.String()
+		}
+	}
+
+	if c.idle != nil {
+		config["max_idle_time"] = c.idle.maxIdle.String()
+	}
+
+	if c.coordinator != nil {
+		config["pin_writes_to_coordinator"] = true
+	}
+
+	switch policy := c.Cluster.RetryPolicy.(type) {
+	case *gocql.SimpleRetryPolicy:
+		config["retry_attempts"] = policy.NumRetries
+	case *gocql.ExponentialBackoffRetryPolicy:
+		config["retry_attempts"] = policy.NumRetries
+		config["retry_backoff"] = true
+	}
+
+	if c.failover {
+		config["failover_contact_points"] = true
+	}
+
+	if c.localDC != "" {
+		config["local_datacenter"] = c.localDC
+	}
+
+	if c.readFallback != nil {
+		config["read_consistency_fallback"] = c.readFallback.consistency.String()
+	}
+
+	if unixSocketDialer, ok := c.Cluster.HostDialer.(*UnixSocketDialer); ok {
+		config["unix_socket"] = unixSocketDialer.path
+	}
+
+	if c.Cluster.SslOpts != nil && c.Cluster.SslOpts.Config != nil {
+		config["tls_host_verification"] = c.Cluster.SslOpts.EnableHostVerification
+		config["tls_client_cert"] = len(c.Cluster.SslOpts.Config.Certificates) > 0
+		config["tls_session_tickets"] = !c.Cluster.SslOpts.Config.SessionTicketsDisabled
+		config["tls_renegotiation"] = tlsRenegotiationName(c.Cluster.SslOpts.Config.Renegotiation)
+	}
+
+	if authenticator, ok := c.Cluster.Authenticator.(gocql.PasswordAuthenticator); ok {
+		config["auth"] = "password"
+		config["username"] = authenticator.Username
+		config["password"] = "<redacted>"
+	}
+
+	return config
+}
+
 func (c *Cluster) SetUserPasswordAuth(username, password string) {
 	c.Cluster.Authenticator = gocql.PasswordAuthenticator{
 		Username: username,
@@ -161,7 +306,115 @@ func (c *Cluster) SetSystemAuthKeyspace(name string) {
 	c.SystemAuthKeyspaceName = name
 }
 
+// SetDisableInitialHostLookup sets whether the driver only connects to the contact points, which
+// is the default, or also discovers the other nodes of the cluster from the system.peers table
+// and connects to them. Discovery cannot be used with a Unix socket host, which is a single node.
+func (c *Cluster) SetDisableInitialHostLookup(disable bool) error {
+	if _, ok := c.Cluster.HostDialer.(*UnixSocketDialer); ok && !disable {
+		return errors.New("the initial host lookup cannot be enabled with a Unix socket host")
+	}
+	c.Cluster.DisableInitialHostLookup = disable
+	return nil
+}
+
+// SetNumConns sets the number of connections the driver opens to each host, 1 by default.
+func (c *Cluster) SetNumConns(n int) {
+	c.Cluster.NumConns = n
+}
+
+// SetConsistency sets the consistency of every query of the primary session, such as QUORUM or
+// LOCAL_QUORUM, so that the reads verifying a change see it on a multi-node cluster. The default
+// is QUORUM. A read session set with SetReadSession keeps its own consistency.
+func (c *Cluster) SetConsistency(consistency string) error {
+	parsed, err := gocql.ParseConsistencyWrapper(strings.ToUpper(consistency))
+	if err != nil {
+		return err
+	}
+	c.Cluster.Consistency = parsed
+	return nil
+}
+
+// WithSystemAuthKeyspace returns a cluster sharing the session and settings of c that reads roles
+// and permissions from the auth keyspace name instead, or c itself when name is empty or already
+// its auth keyspace. Statements such as CREATE ROLE and GRANT are unaffected, since the cluster
+// applies them to its own auth keyspace.
+func (c *Cluster) WithSystemAuthKeyspace(name string) *Cluster {
+	if name == "" || name == c.SystemAuthKeyspaceName {
+		return c
+	}
+	override := *c
+	override.SystemAuthKeyspaceName = name
+	return &override
+}
+
+// SetHostFilterHosts restricts the driver to the given hosts. Hosts may be given with or
+// without a port. When the cluster routes through a proxy, the hosts are matched against the
+// proxied contact points so the filter applies to the dummy addresses gocql actually sees.
+func (c *Cluster) SetHostFilterHosts(hosts []string) (err error) {
+	allowed := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		allowed = append(allowed, c.connectHost(host))
+	}
+
+	// gocql panics when an allowed host cannot be resolved; surface it as an error instead.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to configure host filter: %v", r)
+		}
+	}()
+	c.Cluster.HostFilter = gocql.WhiteListHostFilter(allowed...)
+	return nil
+}
+
+// SetHostFilterDatacenter restricts the driver to hosts in the given datacenter.
+func (c *Cluster) SetHostFilterDatacenter(datacenter string) {
+	c.Cluster.HostFilter = gocql.DataCenterHostFilter(datacenter)
+}
+
+// SetLocalDatacenter makes the driver prefer the nodes of datacenter, sending each query to a
+// replica of its data there, and only use the nodes of other datacenters when none of them is up.
+// An empty datacenter keeps the driver's default round-robin policy.
+func (c *Cluster) SetLocalDatacenter(datacenter string) {
+	c.localDC = datacenter
+	c.Cluster.PoolConfig.HostSelectionPolicy = c.newHostSelectionPolicy()
+}
+
+// newHostSelectionPolicy returns a new policy preferring the datacenter set with
+// SetLocalDatacenter, or nil for the driver's default. gocql does not let sessions share a
+// token-aware policy, so every session created from a copy of c.Cluster needs its own.
+func (c *Cluster) newHostSelectionPolicy() gocql.HostSelectionPolicy {
+	if c.localDC == "" {
+		return nil
+	}
+	return gocql.TokenAwareHostPolicy(gocql.DCAwareRoundRobinPolicy(c.localDC))
+}
+
+// connectHost returns the address gocql connects to for host. Without a proxy it is the host
+// itself; with a proxy it is the dummy host mapped to it.
+func (c *Cluster) connectHost(host string) string {
+	proxyHostDialer, ok := c.Cluster.HostDialer.(*ProxyHostDialer)
+	if !ok {
+		return host
+	}
+	hostPart, portPart, err := net.SplitHostPort(host)
+	if err != nil {
+		hostPart = host
+		portPart = "9042" // default port
+	}
+	realHost := net.JoinHostPort(hostPart, portPart)
+	for dummyHost, mappedHost := range proxyHostDialer.hostMap {
+		if mappedHost == realHost {
+			return dummyHost
+		}
+	}
+	return host
+}
+
 func (c *Cluster) SetTLS(caCert, clientCert, clientKey []byte, enableHostVerification bool) error {
+	if _, ok := c.Cluster.HostDialer.(*UnixSocketDialer); ok {
+		return errors.New("TLS cannot be used with a Unix socket host")
+	}
+
 	caCertPool := x509.NewCertPool()
 	if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
 		return errors.New("failed to append CA certificate")
@@ -177,9 +430,17 @@ func (c *Cluster) SetTLS(caCert, clientCert, clientKey []byte, enableHostVerific
 		if err != nil {
 			return err
 		}
+		// The server may trust a CA other than the one that issued its own certificate, so a
+		// client certificate from an unknown issuer is only reported
+		if err := verifyClientCertificateChain(cert, caCertPool); errors.Is(err, errUnknownClientCertificateIssuer) {
+			log.Printf("Warning: %s", err)
+		} else if err != nil {
+			return err
+		}
 		tlsConfig.Certificates = []tls.Certificate{cert}
-		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
-			return &cert, nil
+		tlsConfig.GetClientCertificate, err = clientCertificateSelector(cert)
+		if err != nil {
+			return err
 		}
 	}
 	c.Cluster.SslOpts = &gocql.SslOptions{
@@ -196,3 +457,80 @@ func (c *Cluster) SetTLS(caCert, clientCert, clientKey []byte, enableHostVerific
 
 	return nil
 }
+
+// errUnknownClientCertificateIssuer is wrapped by verifyClientCertificateChain when the client
+// certificate does not chain to the configured CA certificates.
+var errUnknownClientCertificateIssuer = errors.New("unknown client certificate issuer")
+
+// verifyClientCertificateChain checks that the client certificate bundle lists the leaf first,
+// followed by each intermediate in order, and that it chains to one of roots. The server would
+// otherwise reject the certificate during the handshake with a much less helpful error. When no
+// certificate for its issuer is found, the error wraps errUnknownClientCertificateIssuer.
+func verifyClientCertificateChain(cert tls.Certificate, roots *x509.CertPool) error {
+	chain := make([]*x509.Certificate, 0, len(cert.Certificate))
+	for _, der := range cert.Certificate {
+		parsed, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+		chain = append(chain, parsed)
+	}
+	leaf := chain[0]
+	intermediates := x509.NewCertPool()
+	for i, parsed := range chain[1:] {
+		if err := chain[i].CheckSignatureFrom(parsed); err != nil {
+			return fmt.Errorf("the client certificate bundle is out of order: %q is not issued by %q, which follows it; "+
+				"list the client certificate first, followed by each intermediate certificate up to the CA", chain[i].Subject, parsed.Subject)
+		}
+		intermediates.AddCert(parsed)
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		return fmt.Errorf("%w: the client certificate %q does not chain to the CA certificate: no certificate for its issuer %q was found; "+
+			"append any intermediate certificates to the client certificate, unless the server trusts a different CA",
+			errUnknownClientCertificateIssuer, leaf.Subject, chain[len(chain)-1].Issuer)
+	}
+	if err != nil {
+		return fmt.Errorf("the client certificate %q is not valid: %w", leaf.Subject, err)
+	}
+	return nil
+}
+
+// clientCertificateSelector returns a GetClientCertificate callback for cert. When the server
+// names the CAs it accepts, the certificate is only presented if it was issued by one of them, so
+// a misconfigured certificate fails with a clear error instead of a vague handshake failure.
+func clientCertificateSelector(cert tls.Certificate) (func(*tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
+	// Collect the issuers of the leaf and any intermediates in the chain.
+	issuers := make([][]byte, 0, len(cert.Certificate))
+	var leafSubject string
+	for i, der := range cert.Certificate {
+		parsed, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+		if i == 0 {
+			leafSubject = parsed.Subject.String()
+		}
+		issuers = append(issuers, parsed.RawIssuer)
+	}
+
+	return func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		if len(info.AcceptableCAs) == 0 {
+			return &cert, nil
+		}
+		for _, acceptable := range info.AcceptableCAs {
+			for _, issuer := range issuers {
+				if bytes.Equal(acceptable, issuer) {
+					return &cert, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("the client certificate %q was not issued by any of the %d CAs accepted by the server", leafSubject, len(info.AcceptableCAs))
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+var (
+	// ErrAuthFailed is returned by CreateSession when the cluster rejects the credentials.
+	ErrAuthFailed = errors.New("authentication failed")
+	// ErrTLS is returned by CreateSession when the TLS handshake fails.
+	ErrTLS = errors.New("TLS handshake failed")
+	// ErrUnreachable is returned by CreateSession when no host could be connected to.
+	ErrUnreachable = errors.New("cluster unreachable")
+)
+
+// Fragments of error messages, checked case-insensitively. gocql formats most connection errors
+// with %v, so the underlying error types are usually lost by the time CreateSession returns.
+var (
+	authErrorMessages = []string{
+		"authentication required",
+		"and/or password are incorrect",
+		"bad credentials",
+		"failed to authenticate",
+		"unexpected authenticator",
+	}
+	tlsErrorMessages = []string{
+		"tls:",
+		"x509:",
+		"first record does not look like a tls handshake",
+	}
+	unreachableErrorMessages = []string{
+		"connection refused",
+		"no such host",
+		"no route to host",
+		"network is unreachable",
+		"i/o timeout",
+		"connection reset by peer",
+		"no connections were made",
+		"failed to dial",
+	}
+)
+
+// classifySessionError wraps err with ErrAuthFailed, ErrTLS, or ErrUnreachable when it can be
+// attributed to one of them, keeping the original message. Other errors are returned unchanged.
+func classifySessionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if category := sessionErrorCategory(err); category != nil {
+		return fmt.Errorf("%w: %v", category, err)
+	}
+	return err
+}
+
+// sessionErrorCategory checks for authentication and TLS failures first, since they are often
+// reported wrapped in a network error.
+func sessionErrorCategory(err error) error {
+	message := strings.ToLower(err.Error())
+
+	var requestErr gocql.RequestError
+	if (errors.As(err, &requestErr) && requestErr.Code() == gocql.ErrCodeCredentials) || containsAny(message, authErrorMessages) {
+		return ErrAuthFailed
+	}
+
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalidCert x509.CertificateInvalidError
+	var recordHeader tls.RecordHeaderError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalidCert) ||
+		errors.As(err, &recordHeader) || containsAny(message, tlsErrorMessages) {
+		return ErrTLS
+	}
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) || errors.Is(err, gocql.ErrNoConnectionsStarted) ||
+		containsAny(message, unreachableErrorMessages) {
+		return ErrUnreachable
+	}
+	return nil
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
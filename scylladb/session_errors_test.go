@@ -0,0 +1,144 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentialsError is a gocql.RequestError as returned for a rejected login.
+type credentialsError struct{}
+
+func (credentialsError) Code() int       { return gocql.ErrCodeCredentials }
+func (credentialsError) Message() string { return "bad login" }
+func (credentialsError) Error() string   { return "bad login" }
+
+func TestClassifySessionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{
+			name: "wrong password",
+			err:  errors.New("gocql: unable to create session: unable to discover protocol version: Provided username cassandra and/or password are incorrect"),
+			want: ErrAuthFailed,
+		},
+		{
+			name: "credentials request error",
+			err:  fmt.Errorf("unable to connect: %w", credentialsError{}),
+			want: ErrAuthFailed,
+		},
+		{
+			name: "authentication required",
+			err:  errors.New("gocql: unable to create session: authentication required (using \"org.apache.cassandra.auth.PasswordAuthenticator\")"),
+			want: ErrAuthFailed,
+		},
+		{
+			name: "unknown authority",
+			err:  fmt.Errorf("handshake: %w", x509.UnknownAuthorityError{}),
+			want: ErrTLS,
+		},
+		{
+			name: "plaintext server",
+			err:  errors.New("gocql: unable to create session: tls: first record does not look like a TLS handshake"),
+			want: ErrTLS,
+		},
+		{
+			name: "connection refused",
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connect: connection refused")},
+			want: ErrUnreachable,
+		},
+		{
+			name: "unknown host",
+			err:  &net.DNSError{Err: "no such host", Name: "scylla.invalid", IsNotFound: true},
+			want: ErrUnreachable,
+		},
+		{
+			name: "no connections",
+			err:  gocql.ErrNoConnectionsStarted,
+			want: ErrUnreachable,
+		},
+		{
+			name: "dial timeout",
+			err:  errors.New("gocql: unable to create session: unable to discover protocol version: dial tcp 10.0.0.1:9042: i/o timeout"),
+			want: ErrUnreachable,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := classifySessionError(tc.err)
+			assert.ErrorIs(t, got, tc.want)
+			assert.ErrorContains(t, got, tc.err.Error())
+		})
+	}
+}
+
+func TestClassifySessionError_Unclassified(t *testing.T) {
+	assert.NoError(t, classifySessionError(nil))
+
+	err := errors.New("gocql: unable to create session: keyspace \"missing\" does not exist")
+	got := classifySessionError(err)
+	assert.Same(t, err, got)
+	for _, category := range []error{ErrAuthFailed, ErrTLS, ErrUnreachable} {
+		assert.NotErrorIs(t, got, category)
+	}
+}
+
+func TestCreateSession_Unreachable(t *testing.T) {
+	// Reserve a port and release it so nothing is listening there.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	cluster, err := NewClusterConfig([]string{addr})
+	require.NoError(t, err)
+	cluster.Cluster.ConnectTimeout = time.Second
+
+	assert.ErrorIs(t, cluster.CreateSession(), ErrUnreachable)
+}
+
+func TestCreateSession_TLSUnknownAuthority(t *testing.T) {
+	// The server presents a certificate from a CA the client does not trust.
+	otherCA, err := testutil.GenerateTestCACert()
+	require.NoError(t, err)
+	otherServerCert, err := testutil.GenerateTestServerCert(otherCA)
+	require.NoError(t, err)
+	certPEM, keyPEM, err := otherServerCert.PEMEncodedCert()
+	require.NoError(t, err)
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{keyPair}})
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	cluster, err := NewClusterConfig([]string{listener.Addr().String()})
+	require.NoError(t, err)
+	cluster.Cluster.ConnectTimeout = time.Second
+	require.NoError(t, cluster.SetTLS(caCertPEM, nil, nil, true))
+
+	assert.ErrorIs(t, cluster.CreateSession(), ErrTLS)
+}
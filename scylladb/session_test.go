@@ -3,10 +3,22 @@
 package scylladb
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
 	"testing"
+	"time"
 
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
 	"github.com/retailnext/terraform-provider-scylladb/internal/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -197,3 +209,267 @@ func TestCreateProxyHostMap(t *testing.T) {
 		})
 	}
 }
+
+func TestSetHostFilterHosts(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	err = cluster.SetHostFilterHosts([]string{"127.0.0.1"})
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster.Cluster.HostFilter)
+
+	allowed, err := gocql.NewHostInfoFromAddrPort(net.ParseIP("127.0.0.1"), 9042)
+	assert.NoError(t, err)
+	denied, err := gocql.NewHostInfoFromAddrPort(net.ParseIP("127.0.0.2"), 9042)
+	assert.NoError(t, err)
+	assert.True(t, cluster.Cluster.HostFilter.Accept(allowed))
+	assert.False(t, cluster.Cluster.HostFilter.Accept(denied))
+}
+
+func TestSetHostFilterHosts_ThroughProxy(t *testing.T) {
+	cluster, err := NewClusterConfigWithProxy([]string{"scylla-1.example.com:9042", "scylla-2.example.com"}, "http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	proxyHostDialer, ok := cluster.Cluster.HostDialer.(*ProxyHostDialer)
+	if !ok {
+		t.Fatalf("expected a ProxyHostDialer, got %T", cluster.Cluster.HostDialer)
+	}
+
+	// Only the second host is allowed; it must be matched through its dummy address.
+	err = cluster.SetHostFilterHosts([]string{"scylla-2.example.com"})
+	assert.NoError(t, err)
+	for dummyHost, realHost := range proxyHostDialer.hostMap {
+		host, err := gocql.NewHostInfoFromAddrPort(net.ParseIP(dummyHost), 9042)
+		assert.NoError(t, err)
+		assert.Equal(t, realHost == "scylla-2.example.com:9042", cluster.Cluster.HostFilter.Accept(host), "host: %s", realHost)
+	}
+}
+
+func TestSetHostFilterHosts_Unresolvable(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	err = cluster.SetHostFilterHosts([]string{"does-not-exist.invalid"})
+	assert.Error(t, err)
+}
+
+func TestSetHostFilterDatacenter(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	cluster.SetHostFilterDatacenter("dc1")
+	assert.NotNil(t, cluster.Cluster.HostFilter)
+
+	// A host without datacenter information is not in dc1.
+	host, err := gocql.NewHostInfoFromAddrPort(net.ParseIP("127.0.0.1"), 9042)
+	assert.NoError(t, err)
+	assert.False(t, cluster.Cluster.HostFilter.Accept(host))
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	assert.NoError(t, err)
+	cluster.SetUserPasswordAuth("cassandra", "s3cret")
+	assert.NoError(t, cluster.SetTLS(caCertPEM, clientCertPEM, clientKeyPEM, false))
+
+	config := cluster.EffectiveConfig()
+	assert.Equal(t, []string{"localhost:9042"}, config["hosts"])
+	assert.Equal(t, 1, config["num_conns"])
+	assert.Equal(t, DefaultGrantVerifyAttempts, config["grant_verify_attempts"])
+	assert.Equal(t, "default", config["ddl_mode"])
+	assert.Equal(t, true, config["tls"])
+	assert.Equal(t, false, config["tls_host_verification"])
+	assert.Equal(t, true, config["tls_client_cert"])
+	assert.Equal(t, false, config["proxy"])
+	assert.Equal(t, "password", config["auth"])
+	assert.Equal(t, "cassandra", config["username"])
+	assert.NotContains(t, fmt.Sprint(config), "s3cret")
+	assert.NotContains(t, fmt.Sprint(config), "PRIVATE KEY")
+}
+
+func TestSetConnectionPool(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.True(t, cluster.Cluster.DisableInitialHostLookup)
+	assert.Equal(t, 1, cluster.Cluster.NumConns)
+
+	require.NoError(t, cluster.SetDisableInitialHostLookup(false))
+	cluster.SetNumConns(4)
+	config := cluster.EffectiveConfig()
+	assert.Equal(t, false, config["disable_initial_host_lookup"])
+	assert.Equal(t, 4, config["num_conns"])
+
+	unixSocket, err := NewClusterConfig([]string{"unix:///var/run/scylla/cql.sock"})
+	require.NoError(t, err)
+	assert.ErrorContains(t, unixSocket.SetDisableInitialHostLookup(false), "Unix socket")
+	assert.NoError(t, unixSocket.SetDisableInitialHostLookup(true))
+}
+
+func TestSetConsistency(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.Equal(t, "QUORUM", cluster.EffectiveConfig()["consistency"])
+
+	require.NoError(t, cluster.SetConsistency("local_quorum"))
+	assert.Equal(t, "LOCAL_QUORUM", cluster.EffectiveConfig()["consistency"])
+	assert.Error(t, cluster.SetConsistency("MOST"))
+	assert.Equal(t, "LOCAL_QUORUM", cluster.EffectiveConfig()["consistency"])
+}
+
+func TestSetLocalDatacenter(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.Nil(t, cluster.Cluster.PoolConfig.HostSelectionPolicy)
+	assert.NotContains(t, cluster.EffectiveConfig(), "local_datacenter")
+
+	cluster.SetLocalDatacenter("us-east")
+	policy := cluster.Cluster.PoolConfig.HostSelectionPolicy
+	require.NotNil(t, policy)
+	assert.False(t, policy.IsLocal(&gocql.HostInfo{}), "hosts of other datacenters are remote")
+	assert.Equal(t, "us-east", cluster.EffectiveConfig()["local_datacenter"])
+	assert.NotSame(t, policy, cluster.newHostSelectionPolicy(), "every session gets a policy of its own")
+
+	cluster.SetLocalDatacenter("")
+	assert.Nil(t, cluster.Cluster.PoolConfig.HostSelectionPolicy)
+}
+
+func TestEffectiveConfig_Proxy(t *testing.T) {
+	cluster, err := NewClusterConfigWithProxy([]string{"scylla.example.com"}, "http://proxy:3128")
+	assert.NoError(t, err)
+
+	config := cluster.EffectiveConfig()
+	assert.Equal(t, []string{"127.0.0.1"}, config["hosts"])
+	assert.Equal(t, true, config["proxy"])
+	assert.Equal(t, true, config["dns_through_proxy"])
+	assert.Equal(t, []string{"scylla.example.com:9042"}, config["proxied_hosts"])
+}
+
+func TestSetTLS_AcceptableCAs(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	if err != nil {
+		t.Fatalf("failed to create cluster config: %s", err)
+	}
+	err = cluster.SetTLS(caCertPEM, clientCertPEM, clientKeyPEM, false)
+	assert.NoError(t, err)
+	getClientCertificate := cluster.Cluster.SslOpts.Config.GetClientCertificate
+
+	otherCA, err := testutil.GenerateCert(nil, testutil.CertSubject{
+		CommonName:      "other-ca",
+		Organization:    []string{"Other Org, Inc."},
+		DurationInYears: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to generate CA certificate: %s", err)
+	}
+
+	// testutil.Cert holds the certificate template, so parse the issued certificates for their subjects
+	issuingCA, err := x509.ParseCertificate(caCert.CertBytes)
+	assert.NoError(t, err)
+	unrelatedCA, err := x509.ParseCertificate(otherCA.CertBytes)
+	assert.NoError(t, err)
+
+	// The server does not name any CAs
+	cert, err := getClientCertificate(&tls.CertificateRequestInfo{})
+	assert.NoError(t, err)
+	assert.NotNil(t, cert)
+
+	// The server accepts the CA that issued the client certificate
+	cert, err = getClientCertificate(&tls.CertificateRequestInfo{
+		AcceptableCAs: [][]byte{unrelatedCA.RawSubject, issuingCA.RawSubject},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, cert)
+
+	// The server requests a different CA
+	_, err = getClientCertificate(&tls.CertificateRequestInfo{
+		AcceptableCAs: [][]byte{unrelatedCA.RawSubject},
+	})
+	assert.ErrorContains(t, err, "was not issued by any of the 1 CAs accepted by the server")
+}
+
+func TestSetTLS_ClientCertificateChain(t *testing.T) {
+	intermediate := newTestIntermediateCA(t, caCert)
+	leaf, err := testutil.GenerateCert(intermediate, testutil.CertSubject{
+		CommonName:      "chained-client",
+		Organization:    []string{"My Org, Inc."},
+		DurationInYears: 1,
+	})
+	require.NoError(t, err)
+	leafPEM, leafKeyPEM, err := leaf.PEMEncodedCert()
+	require.NoError(t, err)
+	intermediatePEM, _, err := intermediate.PEMEncodedCert()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		bundle  []byte
+		wantErr string
+	}{
+		{
+			name:   "complete chain",
+			bundle: slices.Concat(leafPEM, intermediatePEM),
+		},
+		{
+			// Only reported, since the server may trust the intermediate
+			name:   "missing intermediate",
+			bundle: leafPEM,
+		},
+		{
+			name:    "misordered intermediates",
+			bundle:  slices.Concat(leafPEM, caCertPEM, intermediatePEM),
+			wantErr: "the client certificate bundle is out of order",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+			require.NoError(t, err)
+			err = cluster.SetTLS(caCertPEM, tc.bundle, leafKeyPEM, false)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	// A client certificate issued by a CA other than the configured one is only reported, since
+	// the server may trust that CA for client certificates
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+	assert.NoError(t, cluster.SetTLS(intermediatePEM, clientCertPEM, clientKeyPEM, true))
+	assert.NotEmpty(t, cluster.Cluster.SslOpts.Config.Certificates)
+
+	leafCert, err := tls.X509KeyPair(leafPEM, leafKeyPEM)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caCertPEM))
+	err = verifyClientCertificateChain(leafCert, roots)
+	assert.ErrorIs(t, err, errUnknownClientCertificateIssuer)
+	assert.ErrorContains(t, err, "does not chain to the CA certificate")
+}
+
+// newTestIntermediateCA issues an intermediate CA certificate signed by parent.
+func newTestIntermediateCA(t *testing.T, parent *testutil.Cert) *testutil.Cert {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "My Intermediate CA", Organization: []string{"My Org, Inc."}},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent.Cert, &key.PublicKey, parent.PrivateKey)
+	require.NoError(t, err)
+	issued, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testutil.Cert{Cert: issued, CertBytes: der, PrivateKey: key}
+}
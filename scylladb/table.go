@@ -0,0 +1,266 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Table is a table definition. Names and types are compared as system_schema reports them:
+// unquoted lower case names and canonical types, e.g. text rather than varchar.
+type Table struct {
+	Keyspace string
+	Name     string
+	// Columns maps each column name, including the primary key columns, to its CQL type.
+	Columns       map[string]string
+	PartitionKey  []string
+	ClusteringKey []string
+	// ClusteringOrder sets the sort order of clustering columns; columns not listed sort
+	// ascending. ListTables reports every clustering column when any of them sorts descending,
+	// and nothing otherwise.
+	ClusteringOrder []ClusteringOrder
+	// Properties maps table properties, such as gc_grace_seconds, to their values; see
+	// TableProperties for the supported names. ListTables reports all of them.
+	Properties map[string]string
+}
+
+// ClusteringOrder is the sort order of one clustering column.
+type ClusteringOrder struct {
+	Column string
+	Desc   bool
+}
+
+// descendingColumns returns the clustering columns of t that sort descending, in clustering
+// key order.
+func (t Table) descendingColumns() []string {
+	var columns []string
+	for _, column := range t.ClusteringKey {
+		for _, order := range t.ClusteringOrder {
+			if order.Column == column && order.Desc {
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
+// Validate checks that the table has a partition key, that every primary key column is defined
+// in Columns, that only clustering columns have a clustering order, and that the properties are
+// supported.
+func (t Table) Validate() error {
+	if len(t.PartitionKey) == 0 {
+		return fmt.Errorf("table %s must have at least one partition key column", t.Name)
+	}
+	for _, column := range slices.Concat(t.PartitionKey, t.ClusteringKey) {
+		if _, ok := t.Columns[column]; !ok {
+			return fmt.Errorf("primary key column %s of table %s is not defined in its columns", column, t.Name)
+		}
+	}
+	for _, order := range t.ClusteringOrder {
+		if !slices.Contains(t.ClusteringKey, order.Column) {
+			return fmt.Errorf("column %s of table %s has a clustering order but is not a clustering column", order.Column, t.Name)
+		}
+	}
+	if _, err := propertiesClause(t.Properties); err != nil {
+		return fmt.Errorf("table %s: %w", t.Name, err)
+	}
+	return nil
+}
+
+// ValidateTableChange returns an error when current cannot be altered into desired in place.
+// Only regular columns can be added or dropped and properties changed; the primary key, the
+// clustering order, and the types of existing columns cannot change.
+func ValidateTableChange(current, desired Table) error {
+	if !slices.Equal(current.PartitionKey, desired.PartitionKey) || !slices.Equal(current.ClusteringKey, desired.ClusteringKey) {
+		return fmt.Errorf("the primary key of table %s cannot be changed", current.Name)
+	}
+	if !slices.Equal(current.descendingColumns(), desired.descendingColumns()) {
+		return fmt.Errorf("the clustering order of table %s cannot be changed", current.Name)
+	}
+	var changed []string
+	for column, currentType := range current.Columns {
+		if desiredType, ok := desired.Columns[column]; ok && !strings.EqualFold(currentType, desiredType) {
+			changed = append(changed, column)
+		}
+	}
+	if len(changed) > 0 {
+		slices.Sort(changed)
+		return fmt.Errorf("the type of columns %s of table %s cannot be changed", strings.Join(changed, ", "), current.Name)
+	}
+	return nil
+}
+
+// createStatement returns the CREATE TABLE statement for t; ifNotExists is the IF NOT EXISTS
+// clause or "".
+func (t Table) createStatement(ifNotExists string) (string, error) {
+	definitions := make([]string, 0, len(t.Columns)+1)
+	for _, column := range slices.Sorted(maps.Keys(t.Columns)) {
+		definitions = append(definitions, column+" "+t.Columns[column])
+	}
+	primaryKey := slices.Concat([]string{"(" + strings.Join(t.PartitionKey, ", ") + ")"}, t.ClusteringKey)
+	definitions = append(definitions, "PRIMARY KEY ("+strings.Join(primaryKey, ", ")+")")
+	query := fmt.Sprintf(`CREATE TABLE %s%s.%s (%s)`, ifNotExists, t.Keyspace, t.Name, strings.Join(definitions, ", "))
+
+	options, err := propertiesClause(t.Properties)
+	if err != nil {
+		return "", err
+	}
+	if descending := t.descendingColumns(); len(descending) > 0 {
+		// Every clustering column is listed, since the clause must follow the clustering key.
+		orders := make([]string, 0, len(t.ClusteringKey))
+		for _, column := range t.ClusteringKey {
+			if slices.Contains(descending, column) {
+				orders = append(orders, column+" DESC")
+			} else {
+				orders = append(orders, column+" ASC")
+			}
+		}
+		options = slices.Insert(options, 0, "CLUSTERING ORDER BY ("+strings.Join(orders, ", ")+")")
+	}
+	if len(options) > 0 {
+		query += " WITH " + strings.Join(options, " AND ")
+	}
+	return query, nil
+}
+
+func (c *Cluster) CreateTable(t Table) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	query, err := t.createStatement(c.ifNotExists(false))
+	if err != nil {
+		return err
+	}
+	log.Printf("Executing CreateTable query: %s", query)
+	return c.execDDL(query)
+}
+
+func (c *Cluster) DropTable(keyspace, name string) error {
+	query := fmt.Sprintf(`DROP TABLE %s%s.%s`, c.ifExists(true), keyspace, name)
+	log.Printf("Executing DropTable query: %s", query)
+	return c.execDDL(query)
+}
+
+// AlterTableColumns adds the regular columns of desired missing from current and drops the
+// regular columns of current missing from desired. The change must pass ValidateTableChange.
+func (c *Cluster) AlterTableColumns(current, desired Table) error {
+	if err := ValidateTableChange(current, desired); err != nil {
+		return err
+	}
+	added, dropped := columnChanges(current, desired)
+	for _, column := range added {
+		query := fmt.Sprintf(`ALTER TABLE %s.%s ADD %s %s`, desired.Keyspace, desired.Name, column, desired.Columns[column])
+		log.Printf("Executing AlterTableColumns query: %s", query)
+		if err := c.execDDL(query); err != nil {
+			return err
+		}
+	}
+	for _, column := range dropped {
+		query := fmt.Sprintf(`ALTER TABLE %s.%s DROP %s`, desired.Keyspace, desired.Name, column)
+		log.Printf("Executing AlterTableColumns query: %s", query)
+		if err := c.execDDL(query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnChanges returns the sorted columns to add to and drop from current to match desired.
+func columnChanges(current, desired Table) (added, dropped []string) {
+	for _, column := range slices.Sorted(maps.Keys(desired.Columns)) {
+		if _, ok := current.Columns[column]; !ok {
+			added = append(added, column)
+		}
+	}
+	for _, column := range slices.Sorted(maps.Keys(current.Columns)) {
+		if _, ok := desired.Columns[column]; !ok {
+			dropped = append(dropped, column)
+		}
+	}
+	return added, dropped
+}
+
+// ListTables reads the definitions of all tables in keyspace from system_schema.columns and
+// their properties from system_schema.tables, sorted by name.
+func (c *Cluster) ListTables(keyspace string) ([]Table, error) {
+	properties, err := c.readTableProperties(keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	query := "SELECT table_name, column_name, kind, position, type, clustering_order FROM system_schema.columns WHERE keyspace_name = ?"
+	iter := c.ReadSession().Query(query, keyspace).IterContext(ctx)
+
+	type keyColumn struct {
+		name     string
+		position int
+		desc     bool
+	}
+	tables := make(map[string]*Table)
+	partitionKeys := make(map[string][]keyColumn)
+	clusteringKeys := make(map[string][]keyColumn)
+	var tableName, columnName, kind, columnType, clusteringOrder string
+	var position int
+	for iter.Scan(&tableName, &columnName, &kind, &position, &columnType, &clusteringOrder) {
+		table, ok := tables[tableName]
+		if !ok {
+			table = &Table{Keyspace: keyspace, Name: tableName, Columns: make(map[string]string), Properties: properties[tableName]}
+			tables[tableName] = table
+		}
+		table.Columns[columnName] = columnType
+		switch kind {
+		case "partition_key":
+			partitionKeys[tableName] = append(partitionKeys[tableName], keyColumn{columnName, position, false})
+		case "clustering":
+			clusteringKeys[tableName] = append(clusteringKeys[tableName], keyColumn{columnName, position, clusteringOrder == "desc"})
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	byPosition := func(a, b keyColumn) int { return a.position - b.position }
+	result := make([]Table, 0, len(tables))
+	for _, name := range slices.Sorted(maps.Keys(tables)) {
+		table := tables[name]
+		slices.SortFunc(partitionKeys[name], byPosition)
+		for _, column := range partitionKeys[name] {
+			table.PartitionKey = append(table.PartitionKey, column.name)
+		}
+		slices.SortFunc(clusteringKeys[name], byPosition)
+		for _, column := range clusteringKeys[name] {
+			table.ClusteringKey = append(table.ClusteringKey, column.name)
+		}
+		if slices.ContainsFunc(clusteringKeys[name], func(column keyColumn) bool { return column.desc }) {
+			for _, column := range clusteringKeys[name] {
+				table.ClusteringOrder = append(table.ClusteringOrder, ClusteringOrder{Column: column.name, Desc: column.desc})
+			}
+		}
+		result = append(result, *table)
+	}
+	return result, nil
+}
+
+// ListTableNames returns the sorted names of the tables in keyspace, read from
+// system_schema.tables. It is cheaper than ListTables when only the names are needed.
+func (c *Cluster) ListTableNames(keyspace string) ([]string, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	iter := c.ReadSession().Query("SELECT table_name FROM system_schema.tables WHERE keyspace_name = ?", keyspace).IterContext(ctx)
+	var names []string
+	var name string
+	for iter.Scan(&name) {
+		names = append(names, name)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,119 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// tableProperties maps each table property Properties supports to the function formatting its
+// value as a CQL literal. Values are given the way ListTables reports them, so that the
+// configured and the stored properties compare equal.
+var tableProperties = map[string]func(string) (string, error){
+	"comment": quoteString,
+	// compaction is the name of the compaction strategy class, e.g. LeveledCompactionStrategy.
+	"compaction": func(value string) (string, error) {
+		if value == "" {
+			return "", errors.New("the compaction strategy cannot be empty")
+		}
+		return fmt.Sprintf("{'class': %s}", quoteLiteral(value)), nil
+	},
+	"default_time_to_live": formatSeconds,
+	"gc_grace_seconds":     formatSeconds,
+}
+
+// TableProperties returns the sorted names of the table properties Properties supports.
+func TableProperties() []string {
+	return slices.Sorted(maps.Keys(tableProperties))
+}
+
+func quoteString(value string) (string, error) {
+	return quoteLiteral(value), nil
+}
+
+func formatSeconds(value string) (string, error) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 || strconv.Itoa(seconds) != value {
+		return "", fmt.Errorf("%q is not a whole number of seconds", value)
+	}
+	return value, nil
+}
+
+// quoteLiteral quotes value as a CQL string literal.
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// propertiesClause formats properties as the options of a WITH clause, sorted by name.
+func propertiesClause(properties map[string]string) ([]string, error) {
+	options := make([]string, 0, len(properties))
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		format, ok := tableProperties[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported table property %s: must be one of %s", name, strings.Join(TableProperties(), ", "))
+		}
+		value, err := format(properties[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid table property %s: %w", name, err)
+		}
+		options = append(options, name+" = "+value)
+	}
+	return options, nil
+}
+
+// AlterTableProperties sets the properties of desired whose values differ from current. Properties
+// of current missing from desired are left unchanged, since they then take no part in the
+// configuration.
+func (c *Cluster) AlterTableProperties(current, desired Table) error {
+	changed := make(map[string]string)
+	for name, value := range desired.Properties {
+		if currentValue, ok := current.Properties[name]; !ok || currentValue != value {
+			changed[name] = value
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	options, err := propertiesClause(changed)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`ALTER TABLE %s.%s WITH %s`, desired.Keyspace, desired.Name, strings.Join(options, " AND "))
+	log.Printf("Executing AlterTableProperties query: %s", query)
+	return c.execDDL(query)
+}
+
+// readTableProperties reads the supported properties of every table in keyspace from
+// system_schema.tables, keyed by table name.
+func (c *Cluster) readTableProperties(keyspace string) (map[string]map[string]string, error) {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	query := "SELECT table_name, comment, compaction, default_time_to_live, gc_grace_seconds FROM system_schema.tables WHERE keyspace_name = ?"
+	iter := c.ReadSession().Query(query, keyspace).IterContext(ctx)
+
+	properties := make(map[string]map[string]string)
+	var tableName, comment string
+	var compaction map[string]string
+	var defaultTimeToLive, gcGraceSeconds int
+	for iter.Scan(&tableName, &comment, &compaction, &defaultTimeToLive, &gcGraceSeconds) {
+		// The strategy may be reported with the package of its Cassandra counterpart.
+		class := compaction["class"]
+		class = class[strings.LastIndex(class, ".")+1:]
+		properties[tableName] = map[string]string{
+			"comment":              comment,
+			"compaction":           class,
+			"default_time_to_live": strconv.Itoa(defaultTimeToLive),
+			"gc_grace_seconds":     strconv.Itoa(gcGraceSeconds),
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
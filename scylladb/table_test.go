@@ -0,0 +1,197 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableCreateStatement(t *testing.T) {
+	table := Table{
+		Keyspace:      "cycling",
+		Name:          "race_times",
+		Columns:       map[string]string{"race_id": "uuid", "year": "int", "rank": "int", "cyclist": "text"},
+		PartitionKey:  []string{"race_id", "year"},
+		ClusteringKey: []string{"rank"},
+	}
+	query, err := table.createStatement("")
+	require.NoError(t, err)
+	assert.Equal(t,
+		`CREATE TABLE cycling.race_times (cyclist text, race_id uuid, rank int, year int, PRIMARY KEY ((race_id, year), rank))`,
+		query)
+
+	table = Table{Keyspace: "cycling", Name: "cyclist_name", Columns: map[string]string{"id": "uuid"}, PartitionKey: []string{"id"}}
+	query, err = table.createStatement("")
+	require.NoError(t, err)
+	assert.Equal(t, `CREATE TABLE cycling.cyclist_name (id uuid, PRIMARY KEY ((id)))`, query)
+	query, err = table.createStatement("IF NOT EXISTS ")
+	require.NoError(t, err)
+	assert.Equal(t, `CREATE TABLE IF NOT EXISTS cycling.cyclist_name (id uuid, PRIMARY KEY ((id)))`, query)
+
+	table = Table{
+		Keyspace:        "cycling",
+		Name:            "rank_by_year",
+		Columns:         map[string]string{"year": "int", "rank": "int", "name": "text"},
+		PartitionKey:    []string{"year"},
+		ClusteringKey:   []string{"rank", "name"},
+		ClusteringOrder: []ClusteringOrder{{Column: "rank", Desc: true}},
+		Properties:      map[string]string{"gc_grace_seconds": "3600", "comment": "it's ranked", "compaction": "LeveledCompactionStrategy"},
+	}
+	query, err = table.createStatement("")
+	require.NoError(t, err)
+	assert.Equal(t,
+		`CREATE TABLE cycling.rank_by_year (name text, rank int, year int, PRIMARY KEY ((year), rank, name)) `+
+			`WITH CLUSTERING ORDER BY (rank DESC, name ASC) AND comment = 'it''s ranked' `+
+			`AND compaction = {'class': 'LeveledCompactionStrategy'} AND gc_grace_seconds = 3600`,
+		query)
+
+	// A time series table reads its newest rows first
+	table = Table{
+		Keyspace:        "metrics",
+		Name:            "samples",
+		Columns:         map[string]string{"sensor": "text", "ts": "timestamp", "value": "double"},
+		PartitionKey:    []string{"sensor"},
+		ClusteringKey:   []string{"ts"},
+		ClusteringOrder: []ClusteringOrder{{Column: "ts", Desc: true}},
+		Properties:      map[string]string{"compaction": "TimeWindowCompactionStrategy"},
+	}
+	query, err = table.createStatement("")
+	require.NoError(t, err)
+	assert.Equal(t,
+		`CREATE TABLE metrics.samples (sensor text, ts timestamp, value double, PRIMARY KEY ((sensor), ts)) `+
+			`WITH CLUSTERING ORDER BY (ts DESC) AND compaction = {'class': 'TimeWindowCompactionStrategy'}`,
+		query)
+}
+
+func TestPropertiesClause(t *testing.T) {
+	options, err := propertiesClause(map[string]string{"default_time_to_live": "86400"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default_time_to_live = 86400"}, options)
+
+	_, err = propertiesClause(map[string]string{"caching": "ALL"})
+	assert.ErrorContains(t, err, "unsupported table property caching")
+	_, err = propertiesClause(map[string]string{"gc_grace_seconds": "1h"})
+	assert.ErrorContains(t, err, "invalid table property gc_grace_seconds")
+	_, err = propertiesClause(map[string]string{"compaction": ""})
+	assert.ErrorContains(t, err, "invalid table property compaction")
+}
+
+func TestTableValidate(t *testing.T) {
+	columns := map[string]string{"id": "uuid", "name": "text"}
+	assert.NoError(t, Table{Name: "t", Columns: columns, PartitionKey: []string{"id"}, ClusteringKey: []string{"name"}}.Validate())
+	assert.ErrorContains(t, Table{Name: "t", Columns: columns}.Validate(), "at least one partition key column")
+	assert.ErrorContains(t, Table{Name: "t", Columns: columns, PartitionKey: []string{"id"}, ClusteringKey: []string{"missing"}}.Validate(),
+		"primary key column missing of table t is not defined")
+	assert.ErrorContains(t, Table{Name: "t", Columns: columns, PartitionKey: []string{"id"}, ClusteringOrder: []ClusteringOrder{{Column: "id", Desc: true}}}.Validate(),
+		"column id of table t has a clustering order but is not a clustering column")
+	assert.ErrorContains(t, Table{Name: "t", Columns: columns, PartitionKey: []string{"id"}, Properties: map[string]string{"ttl": "1"}}.Validate(),
+		"unsupported table property ttl")
+}
+
+func TestValidateTableChange(t *testing.T) {
+	current := Table{Name: "t", Columns: map[string]string{"id": "uuid", "name": "text"}, PartitionKey: []string{"id"}}
+
+	assert.NoError(t, ValidateTableChange(current, Table{Name: "t", Columns: map[string]string{"id": "uuid", "age": "int"}, PartitionKey: []string{"id"}}))
+	assert.ErrorContains(t, ValidateTableChange(current, Table{Name: "t", Columns: current.Columns, PartitionKey: []string{"id"}, ClusteringKey: []string{"name"}}),
+		"primary key of table t cannot be changed")
+	assert.ErrorContains(t, ValidateTableChange(current, Table{Name: "t", Columns: map[string]string{"id": "uuid", "name": "int"}, PartitionKey: []string{"id"}}),
+		"type of columns name of table t cannot be changed")
+
+	clustered := Table{Name: "t", Columns: current.Columns, PartitionKey: []string{"id"}, ClusteringKey: []string{"name"}}
+	descending := clustered
+	descending.ClusteringOrder = []ClusteringOrder{{Column: "name", Desc: true}}
+	ascending := clustered
+	ascending.ClusteringOrder = []ClusteringOrder{{Column: "name"}}
+	assert.NoError(t, ValidateTableChange(clustered, ascending))
+	assert.ErrorContains(t, ValidateTableChange(clustered, descending), "clustering order of table t cannot be changed")
+	descending.Properties = map[string]string{"gc_grace_seconds": "60"}
+	assert.NoError(t, ValidateTableChange(descending, descending))
+}
+
+func TestColumnChanges(t *testing.T) {
+	added, dropped := columnChanges(
+		Table{Columns: map[string]string{"id": "uuid", "b": "text", "a": "text"}},
+		Table{Columns: map[string]string{"id": "uuid", "d": "int", "c": "int"}},
+	)
+	assert.Equal(t, []string{"c", "d"}, added)
+	assert.Equal(t, []string{"a", "b"}, dropped)
+}
+
+func TestTables(t *testing.T) {
+	cluster := newTestCluster(t)
+	defer cluster.Session.Close()
+
+	ks := Keyspace{Name: "schema_ks", ReplicationClass: "SimpleStrategy", ReplicationFactor: 1, DurableWrites: true}
+	require.NoError(t, cluster.CreateKeyspace(ks))
+	require.NoError(t, cluster.AwaitSchemaAgreement())
+
+	defaults := map[string]string{
+		"comment":              "",
+		"compaction":           "SizeTieredCompactionStrategy",
+		"default_time_to_live": "0",
+		"gc_grace_seconds":     "864000",
+	}
+	raceTimes := Table{
+		Keyspace:        ks.Name,
+		Name:            "race_times",
+		Columns:         map[string]string{"race_id": "uuid", "year": "int", "rank": "int", "lap": "int", "cyclist": "text"},
+		PartitionKey:    []string{"year", "race_id"},
+		ClusteringKey:   []string{"rank", "lap"},
+		ClusteringOrder: []ClusteringOrder{{Column: "rank", Desc: false}, {Column: "lap", Desc: true}},
+		Properties: map[string]string{
+			"comment":              "lap times",
+			"compaction":           "LeveledCompactionStrategy",
+			"default_time_to_live": "86400",
+			"gc_grace_seconds":     "3600",
+		},
+	}
+	riders := Table{
+		Keyspace:     ks.Name,
+		Name:         "riders",
+		Columns:      map[string]string{"id": "uuid", "name": "text"},
+		PartitionKey: []string{"id"},
+		Properties:   defaults,
+	}
+	require.NoError(t, cluster.CreateTable(raceTimes))
+	require.NoError(t, cluster.CreateTable(riders))
+	require.NoError(t, cluster.AwaitSchemaAgreement())
+
+	tables, err := cluster.ListTables(ks.Name)
+	require.NoError(t, err)
+	assert.Equal(t, []Table{raceTimes, riders}, tables)
+	names, err := cluster.ListTableNames(ks.Name)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"race_times", "riders"}, names)
+
+	altered := Table{
+		Keyspace:     ks.Name,
+		Name:         "riders",
+		Columns:      map[string]string{"id": "uuid", "team": "text"},
+		PartitionKey: []string{"id"},
+		Properties:   maps.Clone(defaults),
+	}
+	altered.Properties["gc_grace_seconds"] = "60"
+	require.NoError(t, cluster.AlterTableColumns(riders, altered))
+	require.NoError(t, cluster.AlterTableProperties(riders, altered))
+	assert.Error(t, cluster.AlterTableColumns(altered, Table{Keyspace: ks.Name, Name: "riders", Columns: altered.Columns, PartitionKey: []string{"team"}}))
+
+	ks.DurableWrites = false
+	require.NoError(t, cluster.AlterKeyspace(ks))
+	got, err := cluster.GetKeyspace(ks.Name)
+	require.NoError(t, err)
+	assert.Equal(t, ks, got)
+
+	require.NoError(t, cluster.DropTable(ks.Name, "race_times"))
+	tables, err = cluster.ListTables(ks.Name)
+	require.NoError(t, err)
+	assert.Equal(t, []Table{altered}, tables)
+
+	require.NoError(t, cluster.DeleteKeyspace(ks))
+	tables, err = cluster.ListTables(ks.Name)
+	require.NoError(t, err)
+	assert.Empty(t, tables)
+}
@@ -0,0 +1,96 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout matches the gocql default query timeout.
+	DefaultRequestTimeout = 11 * time.Second
+	// DefaultDDLTimeout is higher than DefaultRequestTimeout because schema changes wait for
+	// schema agreement across the cluster.
+	DefaultDDLTimeout = time.Minute
+)
+
+// SetTimeouts sets the timeout for regular queries and for schema-altering (DDL) statements.
+// gocql applies its Timeout as a read deadline on the connection, so it is raised to the larger
+// of the two and each query is bounded by its own context instead.
+func (c *Cluster) SetTimeouts(requestTimeout, ddlTimeout time.Duration) {
+	c.RequestTimeout = requestTimeout
+	c.DDLTimeout = ddlTimeout
+	c.Cluster.Timeout = max(requestTimeout, ddlTimeout)
+}
+
+// SetConnectTimeout bounds connecting to a node, from the TCP connection through the CQL
+// handshake, so that an unreachable or unresponsive node fails the session instead of hanging it.
+// It also bounds resolving the contact points, and the TCP connection unless SetDialer sets its
+// own timeout.
+func (c *Cluster) SetConnectTimeout(timeout time.Duration) {
+	c.Cluster.ConnectTimeout = timeout
+}
+
+// WithContext returns a cluster sharing the session and settings of c whose queries are also
+// bounded by ctx, so that canceling the operation that runs them, e.g. an apply interrupted with
+// Ctrl-C, cancels a long statement instead of waiting for its timeout.
+func (c *Cluster) WithContext(ctx context.Context) *Cluster {
+	override := *c
+	override.ctx = ctx
+	return &override
+}
+
+// operationContext returns the context set with WithContext, or context.Background().
+func (c *Cluster) operationContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// requestContext returns a context derived from parent and bounded by the request timeout. It is
+// called before each query, so it also refreshes the session after an idle period and waits for a
+// contact point to fail over to.
+func (c *Cluster) requestContext(parent context.Context) (context.Context, context.CancelFunc) {
+	c.pingIfIdle()
+	c.awaitContactPoint(parent)
+	return timeoutContext(parent, c.RequestTimeout)
+}
+
+// ddlContext returns a context derived from parent and bounded by the DDL timeout.
+func (c *Cluster) ddlContext(parent context.Context) (context.Context, context.CancelFunc) {
+	c.pingIfIdle()
+	c.awaitContactPoint(parent)
+	return timeoutContext(parent, c.DDLTimeout)
+}
+
+func timeoutContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, timeout)
+}
+
+// exec executes a regular statement within the request timeout.
+func (c *Cluster) exec(stmt string, values ...any) error {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	return c.writeQuery(stmt, values...).ExecContext(ctx)
+}
+
+// execIdempotent executes a regular statement that has the same effect when run again, such as a
+// GRANT, within the request timeout. Unlike exec, it is retried by the policy set with
+// SetRetryPolicy.
+func (c *Cluster) execIdempotent(stmt string, values ...any) error {
+	ctx, cancel := c.requestContext(c.operationContext())
+	defer cancel()
+	return c.writeQuery(stmt, values...).Idempotent(true).ExecContext(ctx)
+}
+
+// execDDL executes a schema-altering statement within the DDL timeout.
+func (c *Cluster) execDDL(stmt string, values ...any) error {
+	ctx, cancel := c.ddlContext(c.operationContext())
+	defer cancel()
+	return c.writeQuery(stmt, values...).ExecContext(ctx)
+}
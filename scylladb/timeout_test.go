@@ -0,0 +1,95 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTimeouts(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+
+	// Defaults: DDL gets a longer timeout than regular requests.
+	assert.Equal(t, DefaultRequestTimeout, cluster.RequestTimeout)
+	assert.Equal(t, DefaultDDLTimeout, cluster.DDLTimeout)
+	assert.Equal(t, DefaultDDLTimeout, cluster.Cluster.Timeout)
+
+	cluster.SetTimeouts(5*time.Second, 2*time.Minute)
+	// gocql's own timeout must not cut DDL statements short.
+	assert.Equal(t, 2*time.Minute, cluster.Cluster.Timeout)
+
+	requestCtx, cancel := cluster.requestContext(context.Background())
+	defer cancel()
+	requestDeadline, ok := requestCtx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(5*time.Second), requestDeadline, time.Second)
+
+	ddlCtx, cancel := cluster.ddlContext(context.Background())
+	defer cancel()
+	ddlDeadline, ok := ddlCtx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), ddlDeadline, time.Second)
+}
+
+// TestSetConnectTimeout verifies that creating a session fails within the connect timeout when a
+// node accepts connections but never answers the CQL handshake.
+func TestSetConnectTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		var conns []net.Conn
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				for _, conn := range conns {
+					conn.Close()
+				}
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+
+	cluster, err := NewClusterConfig([]string{listener.Addr().String()})
+	require.NoError(t, err)
+	cluster.SetConnectTimeout(500 * time.Millisecond)
+	assert.Equal(t, "500ms", cluster.EffectiveConfig()["connect_timeout"])
+
+	start := time.Now()
+	assert.Error(t, cluster.CreateSession())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestTimeoutContextDisabled(t *testing.T) {
+	ctx, cancel := timeoutContext(context.Background(), 0)
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+}
+
+// TestWithContext verifies that the queries of a cluster bound to a context are canceled with it,
+// while still being bounded by their timeout.
+func TestWithContext(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"localhost:9042"})
+	require.NoError(t, err)
+	assert.Equal(t, context.Background(), cluster.operationContext())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := cluster.WithContext(ctx)
+	assert.Nil(t, cluster.ctx, "the cluster itself is not bound")
+	ddlCtx, done := bound.ddlContext(bound.operationContext())
+	defer done()
+	_, ok := ddlCtx.Deadline()
+	assert.True(t, ok)
+	require.NoError(t, ddlCtx.Err())
+	cancel()
+	assert.ErrorIs(t, ddlCtx.Err(), context.Canceled)
+}
@@ -0,0 +1,58 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TLSOptions are advanced TLS settings for networks whose appliances enforce strict TLS policies.
+// The zero value keeps Go's defaults: session tickets are used and renegotiation is refused.
+type TLSOptions struct {
+	// DisableSessionTickets stops the client from resuming sessions with session tickets, so
+	// every connection performs a full handshake.
+	DisableSessionTickets bool
+	// Renegotiation is "never", "once", or "freely", mirroring tls.RenegotiationSupport.
+	// Empty means "never".
+	Renegotiation string
+}
+
+// renegotiationSupport maps the Renegotiation option to its tls.RenegotiationSupport.
+var renegotiationSupport = map[string]tls.RenegotiationSupport{
+	"never":  tls.RenegotiateNever,
+	"once":   tls.RenegotiateOnceAsClient,
+	"freely": tls.RenegotiateFreelyAsClient,
+}
+
+// SetTLSOptions applies opts to the TLS configuration created by SetTLS, which must be called
+// first. The configuration is shared with the proxy dialer, so the options also apply to
+// connections made through a proxy.
+func (c *Cluster) SetTLSOptions(opts TLSOptions) error {
+	if c.Cluster.SslOpts == nil || c.Cluster.SslOpts.Config == nil {
+		return errors.New("TLS options require TLS to be configured")
+	}
+	renegotiation := tls.RenegotiateNever
+	if opts.Renegotiation != "" {
+		var ok bool
+		renegotiation, ok = renegotiationSupport[strings.ToLower(opts.Renegotiation)]
+		if !ok {
+			return fmt.Errorf("invalid TLS renegotiation %q: must be never, once, or freely", opts.Renegotiation)
+		}
+	}
+	c.Cluster.SslOpts.Config.SessionTicketsDisabled = opts.DisableSessionTickets
+	c.Cluster.SslOpts.Config.Renegotiation = renegotiation
+	return nil
+}
+
+// tlsRenegotiationName is the inverse of renegotiationSupport, for EffectiveConfig.
+func tlsRenegotiationName(renegotiation tls.RenegotiationSupport) string {
+	for name, support := range renegotiationSupport {
+		if support == renegotiation {
+			return name
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,50 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTLSOptions(t *testing.T) {
+	proxyHostDialer := &ProxyHostDialer{}
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+	cluster.Cluster.HostDialer = proxyHostDialer
+	require.NoError(t, cluster.SetTLS(caCertPEM, nil, nil, true))
+
+	// Go's defaults are kept until options are set
+	assert.False(t, cluster.Cluster.SslOpts.Config.SessionTicketsDisabled)
+	assert.Equal(t, tls.RenegotiateNever, cluster.Cluster.SslOpts.Config.Renegotiation)
+
+	require.NoError(t, cluster.SetTLSOptions(TLSOptions{DisableSessionTickets: true, Renegotiation: "Once"}))
+	assert.True(t, cluster.Cluster.SslOpts.Config.SessionTicketsDisabled)
+	assert.Equal(t, tls.RenegotiateOnceAsClient, cluster.Cluster.SslOpts.Config.Renegotiation)
+	assert.True(t, proxyHostDialer.tlsConfig.SessionTicketsDisabled)
+	assert.Equal(t, tls.RenegotiateOnceAsClient, proxyHostDialer.tlsConfig.Renegotiation)
+
+	config := cluster.EffectiveConfig()
+	assert.Equal(t, false, config["tls_session_tickets"])
+	assert.Equal(t, "once", config["tls_renegotiation"])
+
+	require.NoError(t, cluster.SetTLSOptions(TLSOptions{Renegotiation: "freely"}))
+	assert.False(t, cluster.Cluster.SslOpts.Config.SessionTicketsDisabled)
+	assert.Equal(t, tls.RenegotiateFreelyAsClient, cluster.Cluster.SslOpts.Config.Renegotiation)
+
+	require.NoError(t, cluster.SetTLSOptions(TLSOptions{}))
+	assert.Equal(t, tls.RenegotiateNever, cluster.Cluster.SslOpts.Config.Renegotiation)
+}
+
+func TestSetTLSOptions_Invalid(t *testing.T) {
+	cluster, err := NewClusterConfig([]string{"127.0.0.1:9042"})
+	require.NoError(t, err)
+	assert.EqualError(t, cluster.SetTLSOptions(TLSOptions{DisableSessionTickets: true}), "TLS options require TLS to be configured")
+
+	require.NoError(t, cluster.SetTLS(caCertPEM, nil, nil, true))
+	assert.EqualError(t, cluster.SetTLSOptions(TLSOptions{Renegotiation: "always"}),
+		`invalid TLS renegotiation "always": must be never, once, or freely`)
+}
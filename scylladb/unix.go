@@ -0,0 +1,61 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	gocql "github.com/apache/cassandra-gocql-driver/v2"
+)
+
+const (
+	// unixSocketScheme prefixes a host that is a path to a Unix domain socket.
+	unixSocketScheme = "unix://"
+	// unixSocketDummyHost is the contact point gocql sees in place of the socket path.
+	unixSocketDummyHost = "127.0.0.1:9042"
+)
+
+// UnixSocketDialer connects every host to a Unix domain socket, bypassing TCP.
+type UnixSocketDialer struct {
+	path   string
+	dialer net.Dialer
+}
+
+func (d *UnixSocketDialer) DialHost(ctx context.Context, _ *gocql.HostInfo) (*gocql.DialedHost, error) {
+	conn, err := d.dialer.DialContext(ctx, "unix", d.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial Unix socket %s: %w", d.path, err)
+	}
+	return &gocql.DialedHost{
+		Conn:            conn,
+		DisableCoalesce: false,
+	}, nil
+}
+
+// IsUnixSocketHost reports whether host has the unix:// form.
+func IsUnixSocketHost(host string) bool {
+	return strings.HasPrefix(host, unixSocketScheme)
+}
+
+// getUnixSocketDialer returns a dialer for hosts given in the unix:///path/to/socket form, along
+// with the dummy contact point to use. It returns a nil dialer when no host is a Unix socket.
+func getUnixSocketDialer(hosts []string) (dialer *UnixSocketDialer, dummyHosts []string, err error) {
+	for _, host := range hosts {
+		if !IsUnixSocketHost(host) {
+			continue
+		}
+		if len(hosts) > 1 {
+			return nil, nil, errors.New("a Unix socket host cannot be combined with other hosts")
+		}
+		path := strings.TrimPrefix(host, unixSocketScheme)
+		if path == "" {
+			return nil, nil, fmt.Errorf("the Unix socket host %q has no path", host)
+		}
+		return &UnixSocketDialer{path: path}, []string{unixSocketDummyHost}, nil
+	}
+	return nil, hosts, nil
+}
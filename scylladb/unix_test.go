@@ -0,0 +1,65 @@
+// Copyright RetailNext, Inc. 2026
+
+package scylladb
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnixSocketHost(t *testing.T) {
+	// Socket paths are limited to about 100 bytes, so avoid the long t.TempDir path
+	dir, err := os.MkdirTemp("", "cql")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "cql.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer listener.Close()
+	accepted := make(chan struct{}, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		accepted <- struct{}{}
+		conn.Close()
+	}()
+
+	cluster, err := NewClusterConfig([]string{"unix://" + socketPath})
+	require.NoError(t, err)
+	assert.IsType(t, &UnixSocketDialer{}, cluster.Cluster.HostDialer)
+	assert.Equal(t, socketPath, cluster.EffectiveConfig()["unix_socket"])
+	cluster.Cluster.ConnectTimeout = time.Second
+
+	// The listener does not speak CQL, so creating the session fails after dialing.
+	assert.Error(t, cluster.CreateSession())
+	select {
+	case <-accepted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cluster never dialed the Unix socket")
+	}
+}
+
+func TestUnixSocketHost_Invalid(t *testing.T) {
+	_, err := NewClusterConfig([]string{"unix://"})
+	assert.Error(t, err)
+
+	_, err = NewClusterConfig([]string{"unix:///run/scylla/cql.sock", "127.0.0.1:9042"})
+	assert.Error(t, err)
+
+	_, err = NewClusterConfigWithProxy([]string{"unix:///run/scylla/cql.sock"}, "http://proxy.example.com:3128")
+	assert.Error(t, err)
+
+	cluster, err := NewClusterConfig([]string{"unix:///run/scylla/cql.sock"})
+	require.NoError(t, err)
+	assert.Error(t, cluster.SetTLS(caCertPEM, nil, nil, true))
+	assert.Error(t, cluster.SetDialer(DialOptions{Timeout: time.Second}))
+}